@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/config"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/application"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/tracing"
+	"go.uber.org/zap"
+)
+
+func main() {
+	once := flag.Bool("once", false, "run the pipeline once and print the summary instead of starting the Lambda handler")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	// Load configuration
+	cfg := config.NewConfig()
+	if err := logger.Init(logger.Config{
+		Level:              cfg.LogLevel,
+		Environment:        cfg.Environment,
+		SamplingInitial:    cfg.LogSamplingInitial,
+		SamplingThereafter: cfg.LogSamplingThereafter,
+	}); err != nil {
+		logger.Error(ctx, "Failed to initialize logger", zap.Error(err))
+		panic(err)
+	}
+	if err := tracing.Init(ctx, tracing.Config{
+		Exporter:     cfg.TracingExporter,
+		OTLPEndpoint: cfg.TracingOtlpEndpoint,
+		SampleRatio:  cfg.TracingSampleRatio,
+		Environment:  cfg.Environment,
+	}); err != nil {
+		logger.Error(ctx, "Failed to initialize tracing", zap.Error(err))
+		panic(err)
+	}
+	if err := metrics.Init(metrics.Config{Exporter: cfg.MetricsExporter, Namespace: cfg.MetricsNamespace}); err != nil {
+		logger.Error(ctx, "Failed to initialize metrics", zap.Error(err))
+		panic(err)
+	}
+	logger.Info(ctx, "Configuration loaded")
+
+	// Initialize application with DI
+	app, err := application.New(cfg)
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize application", zap.Error(err))
+		panic(err)
+	}
+
+	if os.Getenv("AWS_LAMBDA_FUNCTION_NAME") != "" && !*once {
+		// Running in Lambda, triggered by the EventBridge schedule
+		logger.Info(ctx, "Starting ingestion in Lambda mode")
+		lambda.Start(func(ctx context.Context, event events.CloudWatchEvent) (*ingest.Summary, error) {
+			// The scheduled event has no caller, so the run is traced by its event ID
+			ctx = logger.WithTraceID(ctx, event.ID)
+			logger.Info(ctx, "Scheduled ingestion triggered", zap.String("event_id", event.ID), zap.Time("event_time", event.Time))
+			summary, err := runPipeline(ctx, app.Pipeline)
+			// The execution environment may be frozen right after returning, before spans are exported in the background
+			if flushErr := tracing.ForceFlush(ctx); flushErr != nil {
+				logger.Warn(ctx, "Failed to flush spans", zap.Error(flushErr))
+			}
+			if flushErr := metrics.Flush(ctx); flushErr != nil {
+				logger.Warn(ctx, "Failed to flush metrics", zap.Error(flushErr))
+			}
+			return summary, err
+		})
+		return
+	}
+
+	// Running locally: one run, summary on stdout
+	logger.Info(ctx, "Starting ingestion in local mode")
+	summary, runErr := runPipeline(ctx, app.Pipeline)
+	if err := tracing.Shutdown(ctx); err != nil {
+		logger.Warn(ctx, "Failed to flush spans", zap.Error(err))
+	}
+	if err := metrics.Flush(ctx); err != nil {
+		logger.Warn(ctx, "Failed to flush metrics", zap.Error(err))
+	}
+	if summary != nil {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(summary); err != nil {
+			logger.Error(ctx, "Failed to write summary", zap.Error(err))
+		}
+	}
+	if runErr != nil {
+		logger.Error(ctx, "Ingestion failed", zap.Error(runErr))
+		os.Exit(1)
+	}
+}
+
+// runPipeline runs the pipeline in a root span, so that the upstream requests of one run belong to one trace
+func runPipeline(ctx context.Context, pipeline ingest.Pipeline) (summary *ingest.Summary, err error) {
+	ctx, span := tracing.Start(ctx, "Pipeline.Run")
+	defer func() { tracing.End(span, err) }()
+	return pipeline.Run(ctx)
+}
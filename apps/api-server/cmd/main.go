@@ -5,24 +5,54 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
 	chiadapter "github.com/awslabs/aws-lambda-go-api-proxy/chi"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/config"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/application"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/tracing"
+	"go.uber.org/zap"
 )
 
-var chiLambda *chiadapter.ChiLambda
+var (
+	app       *application.Application
+	chiLambda *chiadapter.ChiLambda
+)
 
 func init() {
 	ctx := context.Background()
 
 	// Load configuration
 	cfg := config.NewConfig()
+	if err := logger.Init(logger.Config{
+		Level:              cfg.LogLevel,
+		Environment:        cfg.Environment,
+		SamplingInitial:    cfg.LogSamplingInitial,
+		SamplingThereafter: cfg.LogSamplingThereafter,
+	}); err != nil {
+		logger.Error(ctx, "Failed to initialize logger", zap.Error(err))
+		panic(err)
+	}
+	if err := tracing.Init(ctx, tracing.Config{
+		Exporter:     cfg.TracingExporter,
+		OTLPEndpoint: cfg.TracingOtlpEndpoint,
+		SampleRatio:  cfg.TracingSampleRatio,
+		Environment:  cfg.Environment,
+	}); err != nil {
+		logger.Error(ctx, "Failed to initialize tracing", zap.Error(err))
+		panic(err)
+	}
+	if err := metrics.Init(metrics.Config{Exporter: cfg.MetricsExporter, Namespace: cfg.MetricsNamespace}); err != nil {
+		logger.Error(ctx, "Failed to initialize metrics", zap.Error(err))
+		panic(err)
+	}
 	logger.Info(ctx, "Configuration loaded")
 
 	// Initialize application with DI
-	app, err := application.New(cfg)
+	var err error
+	app, err = application.New(cfg)
 	if err != nil {
 		logger.Error(ctx, "Failed to initialize application")
 		panic(err)
@@ -40,19 +70,23 @@ func main() {
 	if os.Getenv("AWS_LAMBDA_FUNCTION_NAME") != "" {
 		// Running in Lambda
 		logger.Info(ctx, "Starting in Lambda mode")
-		lambda.Start(chiLambda.ProxyWithContext)
+		lambda.Start(func(ctx context.Context, req events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+			resp, err := chiLambda.ProxyWithContext(ctx, req)
+			// The execution environment may be frozen right after returning, before spans are exported in the background
+			if flushErr := tracing.ForceFlush(ctx); flushErr != nil {
+				logger.Warn(ctx, "Failed to flush spans", zap.Error(flushErr))
+			}
+			if flushErr := metrics.Flush(ctx); flushErr != nil {
+				logger.Warn(ctx, "Failed to flush metrics", zap.Error(flushErr))
+			}
+			return resp, err
+		})
 	} else {
-		// Running locally
+		// Running locally. The app built in init is reused: building another one would open the job store again, which
+		// a bbolt file does not allow while the first handle holds its lock.
 		logger.Info(ctx, "Starting in local mode on port 8080")
 
-		// Reload config and app for local development
-		cfg := config.NewConfig()
-		app, err := application.New(cfg)
-		if err != nil {
-			logger.Error(ctx, "Failed to initialize application")
-			panic(err)
-		}
-
+		defer tracing.Shutdown(ctx)
 		http.ListenAndServe(":8080", app.Router)
 	}
 }
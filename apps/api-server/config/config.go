@@ -1,15 +1,65 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"strconv"
 )
 
+// defaultCursorSecret signs cursors when CURSOR_SECRET is not set; it is public, so it is only fit for local use
+const defaultCursorSecret = "local-cursor-secret"
+
 type Config struct {
 	Environment string // dev, prod, local
-	LogLevel    string // info, debug, error
+	LogLevel    string // debug, info, warn, error
+
+	LogSamplingInitial    int // 同じレベル・メッセージのログを1秒ごとに出力する件数。0以下でサンプリングしない
+	LogSamplingThereafter int // LogSamplingInitial を超えた後、何件ごとに1件出力するか
+
+	AccessLogSampleRate        float64 // ステータスが400未満のリクエストのアクセスログを出力する割合 (0〜1)。エラーは常に出力
+	AccessLogHeaders           string  // アクセスログに出力するリクエストヘッダー (カンマ区切り、大文字小文字を区別しない)。それ以外は出力しない
+	AccessLogRedactQueryParams string  // アクセスログで値を伏せるクエリパラメータ (カンマ区切り、大文字小文字を区別しない)
+
+	TracingExporter     string  // トレースの出力先 (none, stdout, otlp)
+	TracingOtlpEndpoint string  // TracingExporter=otlp の場合の OTLP/HTTP エンドポイント。空の場合は OTEL_EXPORTER_OTLP_* 環境変数に従う
+	TracingSampleRatio  float64 // 新しいトレースをサンプリングする割合 (0〜1)。親がサンプリング済みの場合は常に記録
+
+	MetricsExporter  string // メトリクスの出力先 (none, emf, prometheus)
+	MetricsNamespace string // MetricsExporter=emf の場合の CloudWatch 名前空間
+
 	ApiEndpoint string // 外部APIのエンドポイント
-	ApiTimeout  int    // HTTPタイムアウト(秒)
+	ApiTimeout  int    // HTTPタイムアウト(秒)。リトライを含む全体の上限
+
+	ApiAttemptTimeout int // 1回のリクエストのタイムアウト(秒)。0以下で ApiTimeout のみ
+	ApiMaxRetries     int // 上流へのリクエストの最大リトライ回数。0でリトライしない
+	ApiRetryBaseDelay int // 最初のリトライまでの待ち時間の上限(ミリ秒)。リトライごとに倍増
+	ApiRetryMaxDelay  int // リトライまでの待ち時間の上限(ミリ秒)。これより長い Retry-After はリトライしない
+
+	BreakerFailureThreshold int // ソースのサーキットブレーカーを開く連続失敗回数。0以下で無効
+	BreakerOpenTimeout      int // サーキットブレーカーを開いてから試行リクエストを通すまでの時間(秒)
+	BreakerHalfOpenRequests int // サーキットブレーカーを閉じるのに必要な試行リクエストの成功回数
+
+	CacheTTL                  int    // 上流のレスポンスをキャッシュから返す時間(秒)。0以下でキャッシュしない
+	CacheStaleWhileRevalidate int    // TTL 経過後、バックグラウンドで再取得しながら古いレスポンスを返す時間(秒)
+	CacheStaleIfError         int    // TTL 経過後、上流が失敗した場合に古いレスポンスを返す時間(秒)
+	CacheDir                  string // キャッシュを保存するディレクトリ (例: /tmp/upstream-cache)。空の場合はメモリのみ
+
+	CursorSecret string // ページネーション用カーソルの署名鍵
+
+	SalaryBonusMonths  float64 // 月給→年収換算時の賞与月数
+	SalaryHoursPerYear float64 // 時給→年収換算時の年間労働時間
+	UsdJpyRate         float64 // USD→JPY換算レート
+
+	JobStore           string // Jobの保存先 (memory, bolt)
+	JobStorePath       string // JobStore=bolt の場合のファイルパス
+	JobRefreshInterval int    // 上流からJobを再取得する間隔(秒)。0以下で無効
+	JobSources         string // Jobの取得元 (source.Spec の JSON 配列)。空の場合は ApiEndpoint のみ
+
+	DedupMaxDistance int // 同一求人とみなす説明文 SimHash のハミング距離の上限。負の値で重複排除を無効化
+
+	DebugEndpoints bool // /debug/* のエンドポイントを有効にするか。内部の状態を返すため公開環境では無効にする
+	AdminEndpoints bool // /admin/* のエンドポイントを有効にするか。認証なしでプロセスを変更できるため公開環境では無効にする
 }
 
 // NewConfig creates a new Config from environment variables with default values
@@ -17,9 +67,82 @@ func NewConfig() *Config {
 	return &Config{
 		Environment: getEnv("ENVIRONMENT", "local"),
 		LogLevel:    getEnv("LOG_LEVEL", "info"),
+
+		LogSamplingInitial:    getEnvAsInt("LOG_SAMPLING_INITIAL", 0),
+		LogSamplingThereafter: getEnvAsInt("LOG_SAMPLING_THEREAFTER", 100),
+
+		AccessLogSampleRate:        getEnvAsFloat("ACCESS_LOG_SAMPLE_RATE", 1),
+		AccessLogHeaders:           getEnv("ACCESS_LOG_HEADERS", "Accept,Content-Type"),
+		AccessLogRedactQueryParams: getEnv("ACCESS_LOG_REDACT_QUERY_PARAMS", "token,api_key"),
+
+		TracingExporter:     getEnv("TRACING_EXPORTER", "none"),
+		TracingOtlpEndpoint: getEnv("TRACING_OTLP_ENDPOINT", ""),
+		TracingSampleRatio:  getEnvAsFloat("TRACING_SAMPLE_RATIO", 1),
+
+		MetricsExporter:  getEnv("METRICS_EXPORTER", "prometheus"),
+		MetricsNamespace: getEnv("METRICS_NAMESPACE", "JapanTechCareersAPI"),
+
 		ApiEndpoint: getEnv("API_ENDPOINT", "https://api.example.com"),
 		ApiTimeout:  getEnvAsInt("API_TIMEOUT", 30),
+
+		ApiAttemptTimeout: getEnvAsInt("API_ATTEMPT_TIMEOUT", 10),
+		ApiMaxRetries:     getEnvAsInt("API_MAX_RETRIES", 2),
+		ApiRetryBaseDelay: getEnvAsInt("API_RETRY_BASE_DELAY_MS", 200),
+		ApiRetryMaxDelay:  getEnvAsInt("API_RETRY_MAX_DELAY_MS", 5000),
+
+		BreakerFailureThreshold: getEnvAsInt("BREAKER_FAILURE_THRESHOLD", 5),
+		BreakerOpenTimeout:      getEnvAsInt("BREAKER_OPEN_TIMEOUT", 30),
+		BreakerHalfOpenRequests: getEnvAsInt("BREAKER_HALF_OPEN_REQUESTS", 1),
+
+		CacheTTL:                  getEnvAsInt("CACHE_TTL", 60),
+		CacheStaleWhileRevalidate: getEnvAsInt("CACHE_STALE_WHILE_REVALIDATE", 300),
+		CacheStaleIfError:         getEnvAsInt("CACHE_STALE_IF_ERROR", 3600),
+		CacheDir:                  getEnv("CACHE_DIR", ""),
+
+		CursorSecret: getEnv("CURSOR_SECRET", defaultCursorSecret),
+
+		SalaryBonusMonths:  getEnvAsFloat("SALARY_BONUS_MONTHS", 2),
+		SalaryHoursPerYear: getEnvAsFloat("SALARY_HOURS_PER_YEAR", 1920),
+		UsdJpyRate:         getEnvAsFloat("USD_JPY_RATE", 150),
+
+		JobStore:           getEnv("JOB_STORE", "memory"),
+		JobStorePath:       getEnv("JOB_STORE_PATH", "/tmp/jobs.db"),
+		JobRefreshInterval: getEnvAsInt("JOB_REFRESH_INTERVAL", 300),
+		JobSources:         getEnv("JOB_SOURCES", ""),
+
+		DedupMaxDistance: getEnvAsInt("DEDUP_MAX_DISTANCE", 10),
+
+		DebugEndpoints: getEnvAsBool("DEBUG_ENDPOINTS", false),
+		AdminEndpoints: getEnvAsBool("ADMIN_ENDPOINTS", false),
+	}
+}
+
+// Validate reports every setting that is out of range or unsafe for the environment.
+// Settings checked when their component is built, such as LOG_LEVEL and JOB_SOURCES, are not repeated here.
+func (c *Config) Validate() error {
+	var errs []error
+	if c.JobStore != "memory" && c.JobStore != "bolt" {
+		errs = append(errs, fmt.Errorf("JOB_STORE must be memory or bolt, got %q", c.JobStore))
+	}
+	if c.ApiTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("API_TIMEOUT must be positive, got %d", c.ApiTimeout))
+	}
+	if c.BreakerFailureThreshold > 0 && (c.BreakerOpenTimeout <= 0 || c.BreakerHalfOpenRequests <= 0) {
+		errs = append(errs, errors.New("BREAKER_OPEN_TIMEOUT and BREAKER_HALF_OPEN_REQUESTS must be positive when the circuit breaker is enabled"))
+	}
+	if c.TracingSampleRatio < 0 || c.TracingSampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("TRACING_SAMPLE_RATIO must be between 0 and 1, got %g", c.TracingSampleRatio))
+	}
+	if c.AccessLogSampleRate < 0 || c.AccessLogSampleRate > 1 {
+		errs = append(errs, fmt.Errorf("ACCESS_LOG_SAMPLE_RATE must be between 0 and 1, got %g", c.AccessLogSampleRate))
+	}
+	if c.UsdJpyRate <= 0 {
+		errs = append(errs, fmt.Errorf("USD_JPY_RATE must be positive, got %g", c.UsdJpyRate))
+	}
+	if c.Environment != "local" && (c.CursorSecret == "" || c.CursorSecret == defaultCursorSecret) {
+		errs = append(errs, fmt.Errorf("CURSOR_SECRET must be set in the %s environment", c.Environment))
 	}
+	return errors.Join(errs...)
 }
 
 // getEnv gets an environment variable with a fallback default value
@@ -39,3 +162,23 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+// getEnvAsBool gets an environment variable as bool with a fallback default value
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsFloat gets an environment variable as float64 with a fallback default value
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -14,26 +15,116 @@ func TestNewConfig(t *testing.T) {
 		{
 			name: "All environment variables are set",
 			envVars: map[string]string{
-				"ENVIRONMENT":  "production",
-				"LOG_LEVEL":    "debug",
-				"API_ENDPOINT": "https://api.production.com",
-				"API_TIMEOUT":  "60",
+				"ENVIRONMENT":                    "production",
+				"LOG_LEVEL":                      "debug",
+				"LOG_SAMPLING_INITIAL":           "50",
+				"LOG_SAMPLING_THEREAFTER":        "10",
+				"ACCESS_LOG_SAMPLE_RATE":         "0.1",
+				"ACCESS_LOG_HEADERS":             "Referer",
+				"ACCESS_LOG_REDACT_QUERY_PARAMS": "secret",
+				"TRACING_EXPORTER":               "otlp",
+				"TRACING_OTLP_ENDPOINT":          "http://collector:4318/v1/traces",
+				"TRACING_SAMPLE_RATIO":           "0.1",
+				"METRICS_EXPORTER":               "emf",
+				"METRICS_NAMESPACE":              "JapanTechCareersAPI/prod",
+				"API_ENDPOINT":                   "https://api.production.com",
+				"API_TIMEOUT":                    "60",
+				"API_ATTEMPT_TIMEOUT":            "20",
+				"API_MAX_RETRIES":                "4",
+				"API_RETRY_BASE_DELAY_MS":        "100",
+				"API_RETRY_MAX_DELAY_MS":         "2000",
+				"BREAKER_FAILURE_THRESHOLD":      "3",
+				"BREAKER_OPEN_TIMEOUT":           "10",
+				"BREAKER_HALF_OPEN_REQUESTS":     "2",
+				"CACHE_TTL":                      "120",
+				"CACHE_STALE_WHILE_REVALIDATE":   "600",
+				"CACHE_STALE_IF_ERROR":           "7200",
+				"CACHE_DIR":                      "/tmp/upstream-cache",
+				"CURSOR_SECRET":                  "production-secret",
+				"SALARY_BONUS_MONTHS":            "4",
+				"SALARY_HOURS_PER_YEAR":          "2000",
+				"USD_JPY_RATE":                   "145.5",
+				"JOB_STORE":                      "bolt",
+				"JOB_STORE_PATH":                 "/data/jobs.db",
+				"JOB_REFRESH_INTERVAL":           "60",
+				"JOB_SOURCES":                    `[{"type":"lever","board":"acme"}]`,
+				"DEDUP_MAX_DISTANCE":             "6",
+				"DEBUG_ENDPOINTS":                "true",
+				"ADMIN_ENDPOINTS":                "true",
 			},
 			expected: Config{
-				Environment: "production",
-				LogLevel:    "debug",
-				ApiEndpoint: "https://api.production.com",
-				ApiTimeout:  60,
+				Environment:                "production",
+				LogLevel:                   "debug",
+				LogSamplingInitial:         50,
+				LogSamplingThereafter:      10,
+				AccessLogSampleRate:        0.1,
+				AccessLogHeaders:           "Referer",
+				AccessLogRedactQueryParams: "secret",
+				TracingExporter:            "otlp",
+				TracingOtlpEndpoint:        "http://collector:4318/v1/traces",
+				TracingSampleRatio:         0.1,
+				MetricsExporter:            "emf",
+				MetricsNamespace:           "JapanTechCareersAPI/prod",
+				ApiEndpoint:                "https://api.production.com",
+				ApiTimeout:                 60,
+				ApiAttemptTimeout:          20,
+				ApiMaxRetries:              4,
+				ApiRetryBaseDelay:          100,
+				ApiRetryMaxDelay:           2000,
+				BreakerFailureThreshold:    3,
+				BreakerOpenTimeout:         10,
+				BreakerHalfOpenRequests:    2,
+				CacheTTL:                   120,
+				CacheStaleWhileRevalidate:  600,
+				CacheStaleIfError:          7200,
+				CacheDir:                   "/tmp/upstream-cache",
+				CursorSecret:               "production-secret",
+				SalaryBonusMonths:          4,
+				SalaryHoursPerYear:         2000,
+				UsdJpyRate:                 145.5,
+				JobStore:                   "bolt",
+				JobStorePath:               "/data/jobs.db",
+				JobRefreshInterval:         60,
+				JobSources:                 `[{"type":"lever","board":"acme"}]`,
+				DedupMaxDistance:           6,
+				DebugEndpoints:             true,
+				AdminEndpoints:             true,
 			},
 		},
 		{
 			name:    "Environment variables not set and default values are used",
 			envVars: map[string]string{},
 			expected: Config{
-				Environment: "local",
-				LogLevel:    "info",
-				ApiEndpoint: "https://api.example.com",
-				ApiTimeout:  30,
+				Environment:                "local",
+				LogLevel:                   "info",
+				LogSamplingThereafter:      100,
+				AccessLogSampleRate:        1,
+				AccessLogHeaders:           "Accept,Content-Type",
+				AccessLogRedactQueryParams: "token,api_key",
+				TracingExporter:            "none",
+				TracingSampleRatio:         1,
+				MetricsExporter:            "prometheus",
+				MetricsNamespace:           "JapanTechCareersAPI",
+				ApiEndpoint:                "https://api.example.com",
+				ApiTimeout:                 30,
+				ApiAttemptTimeout:          10,
+				ApiMaxRetries:              2,
+				ApiRetryBaseDelay:          200,
+				ApiRetryMaxDelay:           5000,
+				BreakerFailureThreshold:    5,
+				BreakerOpenTimeout:         30,
+				BreakerHalfOpenRequests:    1,
+				CacheTTL:                   60,
+				CacheStaleWhileRevalidate:  300,
+				CacheStaleIfError:          3600,
+				CursorSecret:               "local-cursor-secret",
+				SalaryBonusMonths:          2,
+				SalaryHoursPerYear:         1920,
+				UsdJpyRate:                 150,
+				JobStore:                   "memory",
+				JobStorePath:               "/tmp/jobs.db",
+				JobRefreshInterval:         300,
+				DedupMaxDistance:           10,
 			},
 		},
 		{
@@ -43,10 +134,36 @@ func TestNewConfig(t *testing.T) {
 				"API_TIMEOUT": "45",
 			},
 			expected: Config{
-				Environment: "staging",
-				LogLevel:    "info",
-				ApiEndpoint: "https://api.example.com",
-				ApiTimeout:  45,
+				Environment:                "staging",
+				LogLevel:                   "info",
+				LogSamplingThereafter:      100,
+				AccessLogSampleRate:        1,
+				AccessLogHeaders:           "Accept,Content-Type",
+				AccessLogRedactQueryParams: "token,api_key",
+				TracingExporter:            "none",
+				TracingSampleRatio:         1,
+				MetricsExporter:            "prometheus",
+				MetricsNamespace:           "JapanTechCareersAPI",
+				ApiEndpoint:                "https://api.example.com",
+				ApiTimeout:                 45,
+				ApiAttemptTimeout:          10,
+				ApiMaxRetries:              2,
+				ApiRetryBaseDelay:          200,
+				ApiRetryMaxDelay:           5000,
+				BreakerFailureThreshold:    5,
+				BreakerOpenTimeout:         30,
+				BreakerHalfOpenRequests:    1,
+				CacheTTL:                   60,
+				CacheStaleWhileRevalidate:  300,
+				CacheStaleIfError:          3600,
+				CursorSecret:               "local-cursor-secret",
+				SalaryBonusMonths:          2,
+				SalaryHoursPerYear:         1920,
+				UsdJpyRate:                 150,
+				JobStore:                   "memory",
+				JobStorePath:               "/tmp/jobs.db",
+				JobRefreshInterval:         300,
+				DedupMaxDistance:           10,
 			},
 		},
 		{
@@ -55,10 +172,36 @@ func TestNewConfig(t *testing.T) {
 				"API_TIMEOUT": "invalid",
 			},
 			expected: Config{
-				Environment: "local",
-				LogLevel:    "info",
-				ApiEndpoint: "https://api.example.com",
-				ApiTimeout:  30,
+				Environment:                "local",
+				LogLevel:                   "info",
+				LogSamplingThereafter:      100,
+				AccessLogSampleRate:        1,
+				AccessLogHeaders:           "Accept,Content-Type",
+				AccessLogRedactQueryParams: "token,api_key",
+				TracingExporter:            "none",
+				TracingSampleRatio:         1,
+				MetricsExporter:            "prometheus",
+				MetricsNamespace:           "JapanTechCareersAPI",
+				ApiEndpoint:                "https://api.example.com",
+				ApiTimeout:                 30,
+				ApiAttemptTimeout:          10,
+				ApiMaxRetries:              2,
+				ApiRetryBaseDelay:          200,
+				ApiRetryMaxDelay:           5000,
+				BreakerFailureThreshold:    5,
+				BreakerOpenTimeout:         30,
+				BreakerHalfOpenRequests:    1,
+				CacheTTL:                   60,
+				CacheStaleWhileRevalidate:  300,
+				CacheStaleIfError:          3600,
+				CursorSecret:               "local-cursor-secret",
+				SalaryBonusMonths:          2,
+				SalaryHoursPerYear:         1920,
+				UsdJpyRate:                 150,
+				JobStore:                   "memory",
+				JobStorePath:               "/tmp/jobs.db",
+				JobRefreshInterval:         300,
+				DedupMaxDistance:           10,
 			},
 		},
 		{
@@ -70,10 +213,36 @@ func TestNewConfig(t *testing.T) {
 				"API_TIMEOUT":  "15",
 			},
 			expected: Config{
-				Environment: "dev",
-				LogLevel:    "debug",
-				ApiEndpoint: "https://api.dev.com",
-				ApiTimeout:  15,
+				Environment:                "dev",
+				LogLevel:                   "debug",
+				LogSamplingThereafter:      100,
+				AccessLogSampleRate:        1,
+				AccessLogHeaders:           "Accept,Content-Type",
+				AccessLogRedactQueryParams: "token,api_key",
+				TracingExporter:            "none",
+				TracingSampleRatio:         1,
+				MetricsExporter:            "prometheus",
+				MetricsNamespace:           "JapanTechCareersAPI",
+				ApiEndpoint:                "https://api.dev.com",
+				ApiTimeout:                 15,
+				ApiAttemptTimeout:          10,
+				ApiMaxRetries:              2,
+				ApiRetryBaseDelay:          200,
+				ApiRetryMaxDelay:           5000,
+				BreakerFailureThreshold:    5,
+				BreakerOpenTimeout:         30,
+				BreakerHalfOpenRequests:    1,
+				CacheTTL:                   60,
+				CacheStaleWhileRevalidate:  300,
+				CacheStaleIfError:          3600,
+				CursorSecret:               "local-cursor-secret",
+				SalaryBonusMonths:          2,
+				SalaryHoursPerYear:         1920,
+				UsdJpyRate:                 150,
+				JobStore:                   "memory",
+				JobStorePath:               "/tmp/jobs.db",
+				JobRefreshInterval:         300,
+				DedupMaxDistance:           10,
 			},
 		},
 	}
@@ -99,6 +268,78 @@ func TestNewConfig(t *testing.T) {
 	}
 }
 
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name           string
+		modify         func(cfg *Config)
+		expectedErrors []string
+	}{
+		{
+			name:   "Defaults are valid locally",
+			modify: func(cfg *Config) {},
+		},
+		{
+			name: "Deployed environment with its own cursor secret is valid",
+			modify: func(cfg *Config) {
+				cfg.Environment, cfg.CursorSecret = "prod", "production-secret"
+			},
+		},
+		{
+			name: "Default cursor secret outside local",
+			modify: func(cfg *Config) {
+				cfg.Environment = "prod"
+			},
+			expectedErrors: []string{"CURSOR_SECRET"},
+		},
+		{
+			name: "Every invalid setting is reported",
+			modify: func(cfg *Config) {
+				cfg.JobStore = "redis"
+				cfg.ApiTimeout = 0
+				cfg.BreakerHalfOpenRequests = 0
+				cfg.TracingSampleRatio = 1.5
+				cfg.AccessLogSampleRate = -0.1
+				cfg.UsdJpyRate = 0
+			},
+			expectedErrors: []string{"JOB_STORE", "API_TIMEOUT", "BREAKER_HALF_OPEN_REQUESTS", "TRACING_SAMPLE_RATIO", "ACCESS_LOG_SAMPLE_RATE", "USD_JPY_RATE"},
+		},
+		{
+			name: "Breaker settings are ignored when the breaker is disabled",
+			modify: func(cfg *Config) {
+				cfg.BreakerFailureThreshold, cfg.BreakerOpenTimeout = 0, 0
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			os.Clearenv()
+			cfg := NewConfig()
+			tt.modify(cfg)
+
+			// Act
+			err := cfg.Validate()
+
+			// Assert
+			if len(tt.expectedErrors) == 0 {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Expected an error, got nil")
+			}
+			for _, name := range tt.expectedErrors {
+				if !strings.Contains(err.Error(), name) {
+					t.Errorf("Expected %s to be reported, got %v", name, err)
+				}
+			}
+		})
+	}
+}
+
 func TestGetEnv(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -191,3 +432,60 @@ func TestGetEnvAsInt(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEnvAsFloat(t *testing.T) {
+	tests := []struct {
+		name         string
+		key          string
+		envValue     string
+		defaultValue float64
+		expected     float64
+	}{
+		{
+			name:         "Valid float",
+			key:          "TEST_FLOAT",
+			envValue:     "1.5",
+			defaultValue: 2,
+			expected:     1.5,
+		},
+		{
+			name:         "Valid integer",
+			key:          "TEST_FLOAT_INT",
+			envValue:     "3",
+			defaultValue: 2,
+			expected:     3,
+		},
+		{
+			name:         "Invalid float",
+			key:          "TEST_INVALID_FLOAT",
+			envValue:     "not_a_number",
+			defaultValue: 2,
+			expected:     2,
+		},
+		{
+			name:         "Environment variable does not exist",
+			key:          "NONEXISTENT_FLOAT",
+			envValue:     "",
+			defaultValue: 2,
+			expected:     2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			if tt.envValue != "" {
+				os.Setenv(tt.key, tt.envValue)
+				defer os.Unsetenv(tt.key)
+			}
+
+			// Act
+			result := getEnvAsFloat(tt.key, tt.defaultValue)
+
+			// Assert
+			if result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
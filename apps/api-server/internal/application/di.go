@@ -1,11 +1,26 @@
 package application
 
 import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/config"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/dedup"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/health"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/location"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/repository"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/salary"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/service"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/controller"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/jobstore"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/router"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/source"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/cursor"
 )
 
 // Application holds all dependencies
@@ -14,20 +29,130 @@ type Application struct {
 	Config     *config.Config
 	Controller controller.Controller
 	Service    service.Service
+	Repository repository.JobRepository
+	Pipeline   ingest.Pipeline
 }
 
 // New creates a new Application with all dependencies injected
 func New(cfg *config.Config) (*Application, error) {
-	// Build dependency chain: config -> httpclient -> service -> controller -> router
+	// Build dependency chain: config -> httpclient/sources, repository -> pipeline -> service, health checker -> controller -> router
 	httpClient := httpclient.New(cfg)
-	svc := service.NewServiceImpl(httpClient)
-	ctrl := controller.NewController(svc)
-	r := router.NewRouter(ctrl)
+	salaryParser := salary.NewParser(salary.Config{
+		BonusMonths:  cfg.SalaryBonusMonths,
+		HoursPerYear: cfg.SalaryHoursPerYear,
+		USDJPYRate:   cfg.UsdJpyRate,
+	})
+	locationNormalizer, err := location.NewNormalizer()
+	if err != nil {
+		return nil, err
+	}
+	repo, err := newJobRepository(cfg)
+	if err != nil {
+		return nil, err
+	}
+	sources, err := newSources(cfg, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	sources = decorateSources(cfg, sources)
+	refreshInterval := time.Duration(cfg.JobRefreshInterval) * time.Second
+	var deduplicator ingest.Deduplicator
+	if cfg.DedupMaxDistance >= 0 {
+		deduplicator = dedup.New(dedup.Config{MaxDistance: cfg.DedupMaxDistance})
+	}
+	pipeline := ingest.NewPipeline(repo, sources, deduplicator, salaryParser, locationNormalizer)
+	svc := service.NewServiceImpl(repo, pipeline, cursor.NewCodec(cfg.CursorSecret), refreshInterval)
+	checker := health.NewChecker(
+		health.RepositoryCheck(repo),
+		health.SourcesCheck(pipeline.Breakers),
+		health.ConfigCheck(cfg.Validate),
+	)
+	ctrl := controller.NewController(svc, checker)
+	r := router.NewRouter(ctrl, router.AccessLogConfig{
+		SampleRate:        cfg.AccessLogSampleRate,
+		Headers:           splitList(cfg.AccessLogHeaders),
+		RedactQueryParams: splitList(cfg.AccessLogRedactQueryParams),
+	})
+	if cfg.DebugEndpoints {
+		r.EnableDebugEndpoints()
+	}
+	if cfg.AdminEndpoints {
+		r.EnableAdminEndpoints()
+	}
 
 	return &Application{
 		Router:     r,
 		Config:     cfg,
 		Controller: ctrl,
 		Service:    svc,
+		Repository: repo,
+		Pipeline:   pipeline,
 	}, nil
 }
+
+// newJobRepository creates the JobRepository selected by cfg.JobStore
+func newJobRepository(cfg *config.Config) (repository.JobRepository, error) {
+	switch cfg.JobStore {
+	case "memory":
+		return jobstore.NewMemory(), nil
+	case "bolt":
+		return jobstore.OpenBolt(cfg.JobStorePath)
+	default:
+		return nil, fmt.Errorf("unknown JOB_STORE %q: must be memory or bolt", cfg.JobStore)
+	}
+}
+
+// newSources creates the sources listed in cfg.JobSources, or a single source for cfg.ApiEndpoint when none are listed
+func newSources(cfg *config.Config, httpClient httpclient.HttpClient) ([]ingest.Source, error) {
+	if cfg.JobSources == "" {
+		return []ingest.Source{ingest.NamedSource("default", httpClient)}, nil
+	}
+	specs, err := source.ParseSpecs(cfg.JobSources)
+	if err != nil {
+		return nil, err
+	}
+	return source.NewRegistry().Build(specs, httpclient.NewHTTPClient(cfg))
+}
+
+// decorateSources gives every source its own circuit breaker, so that one failing upstream does not slow down the others,
+// and its own cache on top of it, so that an open circuit serves stale jobs instead of failing.
+// The metrics of the upstream requests are recorded below the breaker, so that only requests actually sent are measured.
+func decorateSources(cfg *config.Config, sources []ingest.Source) []ingest.Source {
+	breakerCfg := httpclient.BreakerConfig{
+		FailureThreshold: cfg.BreakerFailureThreshold,
+		OpenTimeout:      time.Duration(cfg.BreakerOpenTimeout) * time.Second,
+		HalfOpenRequests: cfg.BreakerHalfOpenRequests,
+	}
+	decorated := make([]ingest.Source, len(sources))
+	for i, src := range sources {
+		var client httpclient.HttpClient = httpclient.NewMetricsClient(src.Name(), src)
+		if cfg.BreakerFailureThreshold > 0 {
+			client = httpclient.NewBreakerClient(src.Name(), client, breakerCfg)
+		}
+		if cfg.CacheTTL > 0 {
+			cacheCfg := httpclient.CacheConfig{
+				Name:                 src.Name(),
+				TTL:                  time.Duration(cfg.CacheTTL) * time.Second,
+				StaleWhileRevalidate: time.Duration(cfg.CacheStaleWhileRevalidate) * time.Second,
+				StaleIfError:         time.Duration(cfg.CacheStaleIfError) * time.Second,
+			}
+			if cfg.CacheDir != "" {
+				cacheCfg.PersistDir = filepath.Join(cfg.CacheDir, url.PathEscape(src.Name()))
+			}
+			client = httpclient.NewCachingClient(client, cacheCfg)
+		}
+		decorated[i] = ingest.NamedSource(src.Name(), client)
+	}
+	return decorated
+}
+
+// splitList splits a comma-separated list, dropping empty entries
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
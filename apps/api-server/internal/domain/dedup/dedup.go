@@ -0,0 +1,359 @@
+package dedup
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/bits"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+)
+
+// shingleSize is the number of characters per description shingle. Characters rather than words
+// are used because Japanese text has no spaces.
+const shingleSize = 4
+
+// minShingles is the number of shingles a description needs before its SimHash is trusted
+const minShingles = 8
+
+// Config controls when two postings count as the same job
+type Config struct {
+	// MaxDistance is the largest Hamming distance between the description SimHashes of two postings of the same
+	// company, title and location that are still merged
+	MaxDistance int
+}
+
+// Fingerprint is what postings are compared by
+type Fingerprint struct {
+	Company  string `json:"company"`  // normalized company
+	Title    string `json:"title"`    // normalized title
+	Location string `json:"location"` // prefecture code, or the normalized location when it could not be resolved
+	SimHash  string `json:"simhash,omitempty"`
+
+	simhash uint64
+	hasText bool
+}
+
+// Member is a posting of a cluster
+type Member struct {
+	ID          string      `json:"id"`
+	Source      string      `json:"source,omitempty"`
+	URL         string      `json:"url,omitempty"`
+	Fingerprint Fingerprint `json:"fingerprint"`
+}
+
+// Decision explains why a posting was merged into a cluster
+type Decision struct {
+	ID        string `json:"id"`
+	MatchedID string `json:"matched_id"` // the member it is closest to
+	Distance  int    `json:"distance"`   // Hamming distance between the description SimHashes
+	Reason    string `json:"reason"`
+}
+
+// Cluster is a group of postings merged into one job
+type Cluster struct {
+	CanonicalID string     `json:"canonical_id"`
+	Members     []Member   `json:"members"`
+	Decisions   []Decision `json:"decisions"`
+}
+
+// Report lists the clusters of one deduplication
+type Report struct {
+	GeneratedAt time.Time `json:"generated_at"`
+	Jobs        int       `json:"jobs"`   // postings before merging
+	Merged      int       `json:"merged"` // postings merged into another one
+	Clusters    []Cluster `json:"clusters"`
+}
+
+// Cluster returns the cluster that has a member with the given ID
+func (r Report) Cluster(id string) (Cluster, bool) {
+	for _, c := range r.Clusters {
+		for _, m := range c.Members {
+			if m.ID == id {
+				return c, true
+			}
+		}
+	}
+	return Cluster{}, false
+}
+
+// Deduplicator merges postings of the same job that come from different sources
+type Deduplicator struct {
+	cfg Config
+	now func() time.Time
+}
+
+// New creates a new Deduplicator
+func New(cfg Config) *Deduplicator {
+	return &Deduplicator{cfg: cfg, now: time.Now}
+}
+
+// candidate is a posting being clustered
+type candidate struct {
+	index int
+	job   model.Job
+	fp    Fingerprint
+}
+
+// Deduplicate clusters near-duplicate postings and merges every cluster into its canonical posting.
+// jobs are expected in source priority order; the result keeps the order of the canonical postings.
+func (d *Deduplicator) Deduplicate(jobs []model.Job) ([]model.Job, Report) {
+	report := Report{GeneratedAt: d.now(), Jobs: len(jobs), Clusters: []Cluster{}}
+
+	// Only postings of the same company and title can be duplicates, so they are compared within buckets
+	var clusters [][]candidate
+	var decisions [][]Decision
+	buckets := make(map[string][]int)
+	for i, job := range jobs {
+		c := candidate{index: i, job: job, fp: FingerprintOf(job)}
+		key := c.fp.Company + "\x00" + c.fp.Title
+
+		joined := false
+		for _, ci := range buckets[key] {
+			if decision, ok := d.match(c, clusters[ci]); ok {
+				clusters[ci] = append(clusters[ci], c)
+				decisions[ci] = append(decisions[ci], decision)
+				joined = true
+				break
+			}
+		}
+		if !joined {
+			buckets[key] = append(buckets[key], len(clusters))
+			clusters = append(clusters, []candidate{c})
+			decisions = append(decisions, nil)
+		}
+	}
+
+	merged := make([]model.Job, 0, len(clusters))
+	for ci, members := range clusters {
+		if len(members) == 1 {
+			merged = append(merged, members[0].job)
+			continue
+		}
+		job := merge(members)
+		merged = append(merged, job)
+		report.Merged += len(members) - 1
+
+		cluster := Cluster{CanonicalID: job.ID, Decisions: decisions[ci]}
+		for _, m := range members {
+			cluster.Members = append(cluster.Members, Member{ID: m.job.ID, Source: m.job.Source, URL: m.job.ApplyURL, Fingerprint: m.fp})
+		}
+		report.Clusters = append(report.Clusters, cluster)
+	}
+	return merged, report
+}
+
+// match reports whether c is a duplicate of every member of cluster.
+// Requiring every member, rather than any, keeps a posting without location from chaining Tokyo and Osaka postings together.
+// Postings of the same source are distinct jobs, and postings whose descriptions are too short to compare are never merged,
+// since company, title and location alone match every opening of a team that hires the same role twice.
+func (d *Deduplicator) match(c candidate, cluster []candidate) (Decision, bool) {
+	if !c.fp.hasText {
+		return Decision{}, false
+	}
+	best := Decision{ID: c.job.ID}
+	for _, m := range cluster {
+		if c.job.Source == m.job.Source {
+			return Decision{}, false
+		}
+		if c.fp.Location != "" && m.fp.Location != "" && c.fp.Location != m.fp.Location {
+			return Decision{}, false
+		}
+		if !m.fp.hasText {
+			return Decision{}, false
+		}
+		distance := bits.OnesCount64(c.fp.simhash ^ m.fp.simhash)
+		if distance > d.cfg.MaxDistance {
+			return Decision{}, false
+		}
+		if best.MatchedID == "" || distance < best.Distance {
+			best.MatchedID, best.Distance = m.job.ID, distance
+		}
+	}
+	best.Reason = fmt.Sprintf("same company, title and location from another source; description SimHash distance %d <= %d", best.Distance, d.cfg.MaxDistance)
+	return best, true
+}
+
+// FingerprintOf computes the fingerprint of a job
+func FingerprintOf(job model.Job) Fingerprint {
+	fp := Fingerprint{
+		Company: normalizeCompany(job.Company),
+		Title:   normalizeTitle(job.Title),
+	}
+	if job.Place != nil && job.Place.PrefectureCode != "" {
+		fp.Location = job.Place.PrefectureCode
+	} else {
+		fp.Location = compact(job.Location)
+	}
+	if hash, ok := simHash(job.Description); ok {
+		fp.simhash, fp.hasText = hash, true
+		fp.SimHash = fmt.Sprintf("%016x", hash)
+	}
+	return fp
+}
+
+// simHash computes the 64-bit SimHash of the text's character shingles.
+// It returns false when the text is too short for the hash to mean anything.
+func simHash(text string) (uint64, bool) {
+	runes := []rune(compact(text))
+	if len(runes) < shingleSize+minShingles-1 {
+		return 0, false
+	}
+
+	var weights [64]int
+	for i := 0; i+shingleSize <= len(runes); i++ {
+		h := fnv.New64a()
+		h.Write([]byte(string(runes[i : i+shingleSize])))
+		sum := h.Sum64()
+		for bit := range 64 {
+			if sum&(1<<bit) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	var hash uint64
+	for bit, w := range weights {
+		if w > 0 {
+			hash |= 1 << bit
+		}
+	}
+	return hash, true
+}
+
+// legalForms are legal-form markers that sources include or omit at will. Japanese forms may stand before or after
+// the name; latin forms only after it, separated by a space so that "Zinc" keeps its "inc".
+var (
+	japaneseLegalForms = []string{"株式会社", "合同会社", "有限会社", "(株)", "(有)", "㈱", "㈲"}
+	latinLegalForms    = []string{" co., ltd", " co.,ltd", " co. ltd", " ltd", " inc", " llc", " corporation", " corp", " k.k", " kk", " gk"}
+)
+
+// normalizeCompany folds width and case and drops legal forms, so "株式会社メルカリ" and "メルカリ" are the same company
+func normalizeCompany(s string) string {
+	s = strings.ToLower(foldWidth(s))
+	for trimmed := true; trimmed; {
+		trimmed = false
+		s = strings.Trim(s, " ,.")
+		for _, form := range japaneseLegalForms {
+			if rest, ok := strings.CutPrefix(s, form); ok {
+				s, trimmed = rest, true
+			}
+			if rest, ok := strings.CutSuffix(s, form); ok {
+				s, trimmed = rest, true
+			}
+		}
+		for _, form := range latinLegalForms {
+			if rest, ok := strings.CutSuffix(s, form); ok {
+				s, trimmed = rest, true
+			}
+		}
+	}
+	return compact(s)
+}
+
+// titleNoise are recruiting phrases that some sources add to titles
+var titleNoise = []string{"急募", "募集", "積極採用中", "未経験歓迎", "hiring", "new!", "[new]"}
+
+// normalizeTitle folds width and case and drops recruiting noise, so "【急募】Go エンジニア" and "Goエンジニア" are the same title
+func normalizeTitle(s string) string {
+	s = strings.ToLower(foldWidth(s))
+	for _, noise := range titleNoise {
+		s = strings.ReplaceAll(s, noise, "")
+	}
+	return compact(s)
+}
+
+// compact folds width and case and keeps only letters and digits
+func compact(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(foldWidth(s)) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// foldWidth converts full-width ASCII to half-width
+func foldWidth(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '！' && r <= '～':
+			return r - 0xFEE0
+		case r == '　':
+			return ' '
+		}
+		return r
+	}, s)
+}
+
+// merge combines the members of a cluster into the canonical posting.
+// The canonical posting is the first member, which comes from the source of highest priority, so that the merged job keeps
+// its ID from run to run; the others fill in the fields it lacks.
+func merge(members []candidate) model.Job {
+	job := members[0].job.Clone()
+
+	for _, m := range members[1:] {
+		other := m.job
+		fill(&job.Location, other.Location)
+		fill(&job.Description, other.Description)
+		fill(&job.EmploymentType, other.EmploymentType)
+		fill(&job.RemotePolicy, other.RemotePolicy)
+		fill(&job.SalaryText, other.SalaryText)
+		fill(&job.JapaneseLevel, other.JapaneseLevel)
+		fill(&job.EnglishLevel, other.EnglishLevel)
+		fill(&job.ApplyURL, other.ApplyURL)
+		if job.Place == nil && other.Place != nil {
+			place := *other.Place
+			job.Place = &place
+		}
+		if job.Salary == nil && other.Salary != nil {
+			salary := *other.Salary
+			job.Salary = &salary
+		}
+		// The normalized salary is taken as a whole, so that min, max and confidence stay consistent
+		if job.SalaryMin == 0 && job.SalaryMax == 0 {
+			job.SalaryMin, job.SalaryMax, job.SalaryConfidence = other.SalaryMin, other.SalaryMax, other.SalaryConfidence
+		}
+		if job.VisaSponsorship == nil && other.VisaSponsorship != nil {
+			v := *other.VisaSponsorship
+			job.VisaSponsorship = &v
+		}
+		if job.RelocationSupport == nil && other.RelocationSupport != nil {
+			v := *other.RelocationSupport
+			job.RelocationSupport = &v
+		}
+		for _, tech := range other.TechStack {
+			if !slices.Contains(job.TechStack, tech) {
+				job.TechStack = append(job.TechStack, tech)
+			}
+		}
+		// The posting has been open since it first appeared anywhere
+		if !other.PostedAt.IsZero() && (job.PostedAt.IsZero() || other.PostedAt.Before(job.PostedAt)) {
+			job.PostedAt = other.PostedAt
+		}
+	}
+
+	// The canonical posting's URL comes first, followed by the others in source order
+	job.SourceURLs = nil
+	for _, m := range members {
+		for _, u := range append([]string{m.job.ApplyURL}, m.job.SourceURLs...) {
+			if u != "" && !slices.Contains(job.SourceURLs, u) {
+				job.SourceURLs = append(job.SourceURLs, u)
+			}
+		}
+	}
+	return job
+}
+
+// fill sets *dst to src when *dst is empty
+func fill[T ~string](dst *T, src T) {
+	if *dst == "" {
+		*dst = src
+	}
+}
@@ -0,0 +1,247 @@
+package dedup
+
+import (
+	"math/bits"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+)
+
+const (
+	goDescription    = "Go と AWS を使った決済基盤の開発。マイクロサービスの設計から運用まで担当していただきます。"
+	goDescriptionAlt = "Go と AWS を使った決済基盤の開発。マイクロサービスの設計から運用まで担当していただきます！歓迎: Kubernetes"
+	mlDescription    = "機械学習モデルの研究開発。PyTorch による推薦システムの改善と論文調査をお任せします。"
+)
+
+func TestNormalizeCompany(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "Prefix legal form", input: "株式会社メルカリ", expected: "メルカリ"},
+		{name: "Suffix legal form", input: "メルカリ株式会社", expected: "メルカリ"},
+		{name: "Full-width abbreviation", input: "（株）メルカリ", expected: "メルカリ"},
+		{name: "Latin legal form", input: "Mercari, Inc.", expected: "mercari"},
+		{name: "Latin Co., Ltd.", input: "Acme Co., Ltd.", expected: "acme"},
+		{name: "Legal form inside a word is kept", input: "Zinc", expected: "zinc"},
+		{name: "Full-width letters", input: "ＡＣＭＥ", expected: "acme"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := normalizeCompany(tt.input)
+
+			// Assert
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeTitle(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "Recruiting noise", input: "【急募】Go エンジニア", expected: "goエンジニア"},
+		{name: "Case and spaces", input: "Backend  Engineer", expected: "backendengineer"},
+		{name: "Full-width letters", input: "ＳＲＥ", expected: "sre"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := normalizeTitle(tt.input)
+
+			// Assert
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSimHash(t *testing.T) {
+	// Arrange
+	base, ok := simHash(goDescription)
+	if !ok {
+		t.Fatal("Expected a SimHash for the description")
+	}
+	similar, _ := simHash(goDescriptionAlt)
+	different, _ := simHash(mlDescription)
+
+	// Act
+	near := bits.OnesCount64(base ^ similar)
+	far := bits.OnesCount64(base ^ different)
+
+	// Assert
+	if near >= far {
+		t.Errorf("Expected similar descriptions to be closer than different ones, got %d and %d", near, far)
+	}
+	if _, ok := simHash("Go 開発"); ok {
+		t.Error("Expected no SimHash for a short description")
+	}
+}
+
+func TestDeduplicator_Deduplicate(t *testing.T) {
+	tests := []struct {
+		name           string
+		jobs           []model.Job
+		expectedIDs    []string
+		expectedMerged int
+	}{
+		{
+			name: "Same posting from an ATS and a feed is merged",
+			jobs: []model.Job{
+				{ID: "ats:1", Source: "ats", Title: "Backend Engineer", Company: "株式会社アクメ", Location: "東京都", Description: goDescription},
+				{ID: "feed:1", Source: "feed", Title: "【急募】Backend Engineer", Company: "アクメ", Location: "東京都", Description: goDescriptionAlt},
+			},
+			expectedIDs:    []string{"ats:1"},
+			expectedMerged: 1,
+		},
+		{
+			name: "Different locations are not merged",
+			jobs: []model.Job{
+				{ID: "a:1", Source: "a", Title: "Backend Engineer", Company: "Acme", Location: "東京都", Description: goDescription},
+				{ID: "b:1", Source: "b", Title: "Backend Engineer", Company: "Acme", Location: "大阪府", Description: goDescription},
+			},
+			expectedIDs: []string{"a:1", "b:1"},
+		},
+		{
+			name: "A posting without location does not chain Tokyo and Osaka",
+			jobs: []model.Job{
+				{ID: "a:1", Source: "a", Title: "Backend Engineer", Company: "Acme", Location: "東京都", Description: goDescription},
+				{ID: "b:1", Source: "b", Title: "Backend Engineer", Company: "Acme", Description: goDescription},
+				{ID: "c:1", Source: "c", Title: "Backend Engineer", Company: "Acme", Location: "大阪府", Description: goDescription},
+			},
+			expectedIDs:    []string{"a:1", "c:1"},
+			expectedMerged: 1,
+		},
+		{
+			name: "Different descriptions are not merged",
+			jobs: []model.Job{
+				{ID: "a:1", Source: "a", Title: "Engineer", Company: "Acme", Description: goDescription},
+				{ID: "b:1", Source: "b", Title: "Engineer", Company: "Acme", Description: mlDescription},
+			},
+			expectedIDs: []string{"a:1", "b:1"},
+		},
+		{
+			name: "Descriptions too short to compare are not merged",
+			jobs: []model.Job{
+				{ID: "a:1", Source: "a", Title: "SRE", Company: "Acme Inc.", Location: "東京都"},
+				{ID: "b:1", Source: "b", Title: "SRE", Company: "Acme", Location: "東京都", Description: "SRE 募集"},
+			},
+			expectedIDs: []string{"a:1", "b:1"},
+		},
+		{
+			name: "Postings of the same source are not merged",
+			jobs: []model.Job{
+				{ID: "gh:1", Source: "gh", Title: "Backend Engineer", Company: "Acme", Location: "東京都", Description: goDescription},
+				{ID: "gh:2", Source: "gh", Title: "Backend Engineer", Company: "Acme", Location: "東京都", Description: goDescription},
+			},
+			expectedIDs: []string{"gh:1", "gh:2"},
+		},
+		{
+			name: "Different companies are not merged",
+			jobs: []model.Job{
+				{ID: "a:1", Source: "a", Title: "SRE", Company: "Acme", Description: goDescription},
+				{ID: "b:1", Source: "b", Title: "SRE", Company: "Zinc", Description: goDescription},
+			},
+			expectedIDs: []string{"a:1", "b:1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			d := New(Config{MaxDistance: 10})
+
+			// Act
+			jobs, report := d.Deduplicate(tt.jobs)
+
+			// Assert
+			var ids []string
+			for _, job := range jobs {
+				ids = append(ids, job.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.expectedIDs) {
+				t.Errorf("Expected jobs %v, got %v", tt.expectedIDs, ids)
+			}
+			if report.Jobs != len(tt.jobs) || report.Merged != tt.expectedMerged {
+				t.Errorf("Expected %d jobs and %d merged, got %d and %d", len(tt.jobs), tt.expectedMerged, report.Jobs, report.Merged)
+			}
+			if len(report.Clusters) != min(tt.expectedMerged, 1) {
+				t.Errorf("Expected clusters only for merged jobs, got %d", len(report.Clusters))
+			}
+		})
+	}
+}
+
+func TestDeduplicator_Deduplicate_Merge(t *testing.T) {
+	// Arrange: 優先度の高い ATS が先。RSS側だけが給与と技術スタックを持ち、ATS側より項目が多い
+	visa := true
+	jobs := []model.Job{
+		{
+			ID: "ats:1", Source: "ats", Title: "Backend Engineer", Company: "株式会社アクメ", Location: "東京都", Description: goDescription,
+			EmploymentType: model.EmploymentFullTime, VisaSponsorship: &visa, TechStack: []string{"Go", "AWS"},
+			PostedAt: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC), ApplyURL: "https://ats.example/1",
+		},
+		{
+			ID: "feed:9", Source: "feed", Title: "Backend Engineer", Company: "アクメ", Description: goDescription,
+			RemotePolicy: model.RemoteHybrid, SalaryText: "年収800万円〜", SalaryMin: 8000000, SalaryConfidence: 0.8,
+			TechStack: []string{"Go", "Kubernetes"}, PostedAt: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), ApplyURL: "https://feed.example/9",
+		},
+	}
+	d := New(Config{MaxDistance: 3})
+	d.now = func() time.Time { return time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC) }
+
+	// Act
+	merged, report := d.Deduplicate(jobs)
+
+	// Assert
+	if len(merged) != 1 {
+		t.Fatalf("Expected 1 job, got %d", len(merged))
+	}
+	job := merged[0]
+	if job.ID != "ats:1" || job.Source != "ats" {
+		t.Errorf("Expected the posting of the first source to be canonical, got %s from %s", job.ID, job.Source)
+	}
+	if job.Location != "東京都" || job.EmploymentType != model.EmploymentFullTime || job.VisaSponsorship == nil {
+		t.Errorf("Expected the fields of the canonical posting to be kept, got %+v", job)
+	}
+	if job.SalaryText != "年収800万円〜" || job.SalaryMin != 8000000 || job.SalaryConfidence != 0.8 {
+		t.Errorf("Expected the salary to be filled from the feed, got %q %d %v", job.SalaryText, job.SalaryMin, job.SalaryConfidence)
+	}
+	if !reflect.DeepEqual(job.TechStack, []string{"Go", "AWS", "Kubernetes"}) {
+		t.Errorf("Expected the union of the tech stacks, got %v", job.TechStack)
+	}
+	if job.RemotePolicy != model.RemoteHybrid {
+		t.Errorf("Expected the remote policy to be filled from the feed, got %q", job.RemotePolicy)
+	}
+	if !job.PostedAt.Equal(jobs[1].PostedAt) {
+		t.Errorf("Expected the earliest posted date, got %v", job.PostedAt)
+	}
+	if !reflect.DeepEqual(job.SourceURLs, []string{"https://ats.example/1", "https://feed.example/9"}) {
+		t.Errorf("Expected every source URL, got %v", job.SourceURLs)
+	}
+
+	cluster, ok := report.Cluster("feed:9")
+	if !ok {
+		t.Fatal("Expected a cluster for feed:9")
+	}
+	if cluster.CanonicalID != "ats:1" || len(cluster.Members) != 2 {
+		t.Errorf("Expected a cluster of 2 with canonical ats:1, got %+v", cluster)
+	}
+	if len(cluster.Decisions) != 1 || cluster.Decisions[0].ID != "feed:9" || cluster.Decisions[0].MatchedID != "ats:1" || cluster.Decisions[0].Distance != 0 {
+		t.Errorf("Expected feed:9 to be matched with ats:1 at distance 0, got %+v", cluster.Decisions)
+	}
+	if _, ok := report.Cluster("other"); ok {
+		t.Error("Expected no cluster for a job that was not merged")
+	}
+}
@@ -0,0 +1,170 @@
+package health
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/repository"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/buildinfo"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"go.uber.org/zap"
+)
+
+// checkTimeout bounds every check, so that one hanging dependency does not hang the readiness probe
+const checkTimeout = 2 * time.Second
+
+// Status is the health of a component or of the whole service, from best to worst
+type Status string
+
+const (
+	StatusUp       Status = "up"
+	StatusDegraded Status = "degraded" // serving, with reduced capability
+	StatusDown     Status = "down"     // not able to serve
+)
+
+// severity orders the statuses so that the worst one wins
+var severity = map[Status]int{StatusUp: 0, StatusDegraded: 1, StatusDown: 2}
+
+// Component is the result of one check
+type Component struct {
+	Name      string  `json:"name"`
+	Status    Status  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Detail    string  `json:"detail,omitempty"`
+}
+
+// Report is the readiness of the service: the worst status of its components
+type Report struct {
+	Status     Status         `json:"status"`
+	CheckedAt  time.Time      `json:"checked_at"`
+	Build      buildinfo.Info `json:"build"`
+	Components []Component    `json:"components"`
+}
+
+// Probe checks a dependency. The detail explains any status other than StatusUp.
+type Probe func(ctx context.Context) (status Status, detail string)
+
+// Check is a named Probe
+type Check struct {
+	Name  string
+	Probe Probe
+}
+
+// Checker is the interface for checking whether the service is ready to serve requests
+type Checker interface {
+	Readiness(ctx context.Context) Report
+}
+
+// CheckerImpl implements the Checker interface
+type CheckerImpl struct {
+	checks  []Check
+	timeout time.Duration
+	now     func() time.Time
+}
+
+// NewChecker creates a CheckerImpl running checks, which are reported in the given order
+func NewChecker(checks ...Check) *CheckerImpl {
+	return &CheckerImpl{checks: checks, timeout: checkTimeout, now: time.Now}
+}
+
+// Readiness runs every check concurrently and reports the result of each
+func (c *CheckerImpl) Readiness(ctx context.Context) Report {
+	report := Report{
+		Status:     StatusUp,
+		CheckedAt:  c.now(),
+		Build:      buildinfo.Get(),
+		Components: make([]Component, len(c.checks)),
+	}
+
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Go(func() {
+			report.Components[i] = c.run(ctx, check)
+		})
+	}
+	wg.Wait()
+
+	for _, component := range report.Components {
+		if severity[component.Status] > severity[report.Status] {
+			report.Status = component.Status
+		}
+	}
+	return report
+}
+
+// run runs one check within c.timeout. A probe that does not return in time leaves the component down.
+func (c *CheckerImpl) run(ctx context.Context, check Check) Component {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	type result struct {
+		status Status
+		detail string
+	}
+	done := make(chan result, 1)
+	start := c.now()
+	go func() {
+		status, detail := check.Probe(ctx)
+		done <- result{status, detail}
+	}()
+
+	component := Component{Name: check.Name}
+	select {
+	case r := <-done:
+		component.Status, component.Detail = r.status, r.detail
+	case <-ctx.Done():
+		component.Status, component.Detail = StatusDown, fmt.Sprintf("check did not finish: %v", ctx.Err())
+	}
+	component.LatencyMs = float64(c.now().Sub(start)) / float64(time.Millisecond)
+	return component
+}
+
+// RepositoryCheck reports the job repository down when it cannot be reached.
+// The readiness report is public, so the cause, which may name paths or hosts, is logged rather than reported.
+func RepositoryCheck(repo repository.JobRepository) Check {
+	return Check{Name: "repository", Probe: func(ctx context.Context) (Status, string) {
+		if err := repo.Ping(ctx); err != nil {
+			logger.Error(ctx, "Job repository is unreachable", zap.Error(err))
+			return StatusDown, "job repository is unreachable"
+		}
+		return StatusUp, ""
+	}}
+}
+
+// SourcesCheck judges the reachability of the upstream sources from their circuit breakers, without sending any
+// request: the service is degraded while any circuit is open, even all of them, since the cache and the repository still
+// serve their jobs; taking the service out of rotation would not bring the upstreams back. A half-open circuit is
+// already letting trial requests through.
+func SourcesCheck(breakers func() []httpclient.BreakerStatus) Check {
+	return Check{Name: "sources", Probe: func(ctx context.Context) (Status, string) {
+		statuses := breakers()
+		var open []string
+		for _, b := range statuses {
+			if b.State == httpclient.BreakerOpen {
+				open = append(open, b.Name)
+			}
+		}
+		if len(open) == 0 {
+			return StatusUp, ""
+		}
+		return StatusDegraded, fmt.Sprintf("circuit open for %s", strings.Join(open, ", "))
+	}}
+}
+
+// ConfigCheck reports the service down when validate finds the configuration invalid.
+// Which settings are invalid is logged rather than reported, like the cause of RepositoryCheck.
+func ConfigCheck(validate func() error) Check {
+	return Check{Name: "config", Probe: func(ctx context.Context) (Status, string) {
+		if err := validate(); err != nil {
+			logger.Error(ctx, "Configuration is invalid", zap.Error(err))
+			return StatusDown, "configuration is invalid"
+		}
+		return StatusUp, ""
+	}}
+}
@@ -0,0 +1,189 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	mock_repository "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/repository/mock"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger/loggertest"
+	"go.uber.org/mock/gomock"
+)
+
+// fixed is a check that always reports status
+func fixed(name string, status Status) Check {
+	return Check{Name: name, Probe: func(ctx context.Context) (Status, string) { return status, "" }}
+}
+
+func TestCheckerImpl_Readiness(t *testing.T) {
+	// hang is closed once the test is over, releasing the probe that does not finish in time
+	hang := make(chan struct{})
+	defer close(hang)
+
+	tests := []struct {
+		name             string
+		checks           []Check
+		expectedStatus   Status
+		expectedStatuses []Status // per component, in check order
+	}{
+		{
+			name:             "Up when every component is up",
+			checks:           []Check{fixed("a", StatusUp), fixed("b", StatusUp)},
+			expectedStatus:   StatusUp,
+			expectedStatuses: []Status{StatusUp, StatusUp},
+		},
+		{
+			name:             "Degraded component degrades the service",
+			checks:           []Check{fixed("a", StatusUp), fixed("b", StatusDegraded)},
+			expectedStatus:   StatusDegraded,
+			expectedStatuses: []Status{StatusUp, StatusDegraded},
+		},
+		{
+			name:             "Down component takes the service down",
+			checks:           []Check{fixed("a", StatusDown), fixed("b", StatusDegraded)},
+			expectedStatus:   StatusDown,
+			expectedStatuses: []Status{StatusDown, StatusDegraded},
+		},
+		{
+			name: "Check that does not finish in time is down",
+			checks: []Check{fixed("a", StatusUp), {Name: "b", Probe: func(ctx context.Context) (Status, string) {
+				<-hang
+				return StatusUp, ""
+			}}},
+			expectedStatus:   StatusDown,
+			expectedStatuses: []Status{StatusUp, StatusDown},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			checker := NewChecker(tt.checks...)
+			checker.timeout = 10 * time.Millisecond
+
+			// Act
+			report := checker.Readiness(context.Background())
+
+			// Assert
+			if report.Status != tt.expectedStatus {
+				t.Errorf("Expected status '%s', got '%s'", tt.expectedStatus, report.Status)
+			}
+			statuses := make([]Status, len(report.Components))
+			for i, component := range report.Components {
+				if component.Name != tt.checks[i].Name {
+					t.Errorf("Expected component %d to be '%s', got '%s'", i, tt.checks[i].Name, component.Name)
+				}
+				statuses[i] = component.Status
+			}
+			if !reflect.DeepEqual(statuses, tt.expectedStatuses) {
+				t.Errorf("Expected statuses %v, got %v", tt.expectedStatuses, statuses)
+			}
+			if report.Build.Commit == "" || report.CheckedAt.IsZero() {
+				t.Errorf("Expected build info and check time, got %+v", report)
+			}
+		})
+	}
+}
+
+func TestRepositoryCheck(t *testing.T) {
+	tests := []struct {
+		name           string
+		pingErr        error
+		expectedStatus Status
+		expectedDetail string
+	}{
+		{name: "Reachable repository is up", expectedStatus: StatusUp},
+		{
+			name:           "Unreachable repository is down without the cause",
+			pingErr:        apperr.New(apperr.Internal, "failed to open /data/jobs.db"),
+			expectedStatus: StatusDown,
+			expectedDetail: "job repository is unreachable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			logs := loggertest.Setup(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mock_repository.NewMockJobRepository(ctrl)
+			repo.EXPECT().Ping(gomock.Any()).Return(tt.pingErr)
+
+			// Act
+			status, detail := RepositoryCheck(repo).Probe(context.Background())
+
+			// Assert: 原因は公開されるレスポンスではなくログに出す
+			if status != tt.expectedStatus || detail != tt.expectedDetail {
+				t.Errorf("Expected '%s' (%s), got '%s' (%s)", tt.expectedStatus, tt.expectedDetail, status, detail)
+			}
+			if logged := logs.FilterFieldKey("error").Len(); (tt.pingErr != nil) != (logged == 1) {
+				t.Errorf("Expected the cause to be logged once when the ping fails, got %d logs", logged)
+			}
+		})
+	}
+}
+
+func TestSourcesCheck(t *testing.T) {
+	tests := []struct {
+		name           string
+		breakers       []httpclient.BreakerStatus
+		expectedStatus Status
+		expectedDetail string
+	}{
+		{
+			name:           "No breakers is up",
+			expectedStatus: StatusUp,
+		},
+		{
+			name:           "Closed and half-open breakers are up",
+			breakers:       []httpclient.BreakerStatus{{Name: "a", State: httpclient.BreakerClosed}, {Name: "b", State: httpclient.BreakerHalfOpen}},
+			expectedStatus: StatusUp,
+		},
+		{
+			name:           "Some open breakers degrade the service",
+			breakers:       []httpclient.BreakerStatus{{Name: "a", State: httpclient.BreakerOpen}, {Name: "b", State: httpclient.BreakerClosed}},
+			expectedStatus: StatusDegraded,
+			expectedDetail: "circuit open for a",
+		},
+		{
+			name:           "Every breaker open still only degrades the service",
+			breakers:       []httpclient.BreakerStatus{{Name: "a", State: httpclient.BreakerOpen}, {Name: "b", State: httpclient.BreakerOpen}},
+			expectedStatus: StatusDegraded,
+			expectedDetail: "circuit open for a, b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			status, detail := SourcesCheck(func() []httpclient.BreakerStatus { return tt.breakers }).Probe(context.Background())
+
+			// Assert
+			if status != tt.expectedStatus || detail != tt.expectedDetail {
+				t.Errorf("Expected '%s' (%s), got '%s' (%s)", tt.expectedStatus, tt.expectedDetail, status, detail)
+			}
+		})
+	}
+}
+
+func TestConfigCheck(t *testing.T) {
+	// Arrange
+	logs := loggertest.Setup(t)
+
+	// Act
+	status, detail := ConfigCheck(func() error { return errors.New("JOB_STORE must be memory or bolt") }).Probe(context.Background())
+
+	// Assert: どの設定が不正かはログにのみ出す
+	if status != StatusDown || detail != "configuration is invalid" {
+		t.Errorf("Expected the invalid config to be down with a generic detail, got '%s' (%s)", status, detail)
+	}
+	if logs.FilterMessage("Configuration is invalid").Len() != 1 {
+		t.Error("Expected the invalid settings to be logged")
+	}
+}
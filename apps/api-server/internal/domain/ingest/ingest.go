@@ -0,0 +1,403 @@
+package ingest
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/dedup"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/repository"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics"
+	"go.uber.org/zap"
+)
+
+// missTTL is how long FetchJob answers not found for an ID without asking the upstream again
+const missTTL = time.Minute
+
+// Source is an upstream that jobs are pulled from
+type Source interface {
+	Name() string
+	httpclient.HttpClient
+}
+
+// namedSource gives a name to a plain HttpClient
+type namedSource struct {
+	httpclient.HttpClient
+	name string
+}
+
+func (s namedSource) Name() string {
+	return s.name
+}
+
+// Unwrap returns the named client
+func (s namedSource) Unwrap() httpclient.HttpClient {
+	return s.HttpClient
+}
+
+// NamedSource wraps client as a Source called name
+func NamedSource(name string, client httpclient.HttpClient) Source {
+	return namedSource{HttpClient: client, name: name}
+}
+
+// Normalizer derives canonical fields of a job, such as the annual JPY salary, from what the upstream posted
+type Normalizer interface {
+	Normalize(job *model.Job)
+}
+
+// Summary reports what a pipeline run did
+type Summary struct {
+	StartedAt     time.Time     `json:"started_at"`
+	Duration      time.Duration `json:"duration_ns"`
+	Fetched       int           `json:"fetched"`    // jobs returned by the sources
+	New           int           `json:"new"`        // jobs stored for the first time
+	Updated       int           `json:"updated"`    // stored jobs whose content changed
+	Unchanged     int           `json:"unchanged"`  // stored jobs whose content did not change
+	Duplicates    int           `json:"duplicates"` // jobs whose ID another source already returned
+	Merged        int           `json:"merged"`     // jobs merged into a near-duplicate posting of another source
+	Expired       int           `json:"expired"`    // jobs deleted because they expired or are no longer listed
+	Failed        int           `json:"failed"`     // jobs that were invalid or could not be stored
+	FailedSources []string      `json:"failed_sources,omitempty"`
+}
+
+// Pipeline pulls jobs from the sources into the repository
+type Pipeline interface {
+	// Run fetches every source, normalizes and dedupes the jobs and upserts them.
+	// It returns an error only when every source failed; the summary is returned either way.
+	Run(ctx context.Context) (*Summary, error)
+	// FetchJob fetches a single job from the source named by its ID prefix and stores it
+	FetchJob(ctx context.Context, id string) (*model.Job, error)
+	// DedupReport returns the merge decisions of the last successful run, or nil before the first run
+	DedupReport() *dedup.Report
+	// Breakers returns the circuit breaker state of the sources that are guarded by one
+	Breakers() []httpclient.BreakerStatus
+}
+
+// breakerSource is a client guarded by a circuit breaker, such as *httpclient.BreakerClient
+type breakerSource interface {
+	BreakerStatus() httpclient.BreakerStatus
+}
+
+// wrapper is a client decorating another one, such as *httpclient.CachingClient
+type wrapper interface {
+	Unwrap() httpclient.HttpClient
+}
+
+// Deduplicator merges postings of the same job that come from different sources
+type Deduplicator interface {
+	Deduplicate(jobs []model.Job) ([]model.Job, dedup.Report)
+}
+
+// PipelineImpl implements the Pipeline interface
+type PipelineImpl struct {
+	repo        repository.JobRepository
+	sources     []Source
+	dedup       Deduplicator
+	normalizers []Normalizer
+	now         func() time.Time
+
+	mu     sync.RWMutex
+	report *dedup.Report
+
+	missMu sync.Mutex
+	misses map[string]time.Time // IDs that FetchJob did not find, with the time of the lookup
+}
+
+// NewPipeline creates a new PipelineImpl.
+// normalizers are applied in order to every fetched job before it is validated; dedup, when not nil, then merges
+// near-duplicate postings across sources.
+func NewPipeline(repo repository.JobRepository, sources []Source, dedup Deduplicator, normalizers ...Normalizer) Pipeline {
+	return &PipelineImpl{
+		repo:        repo,
+		sources:     sources,
+		dedup:       dedup,
+		normalizers: normalizers,
+		now:         time.Now,
+		misses:      make(map[string]time.Time),
+	}
+}
+
+// fetchResult is the outcome of fetching one source
+type fetchResult struct {
+	jobs []model.Job
+	err  error
+}
+
+// Run executes one ingestion run and records its metrics
+func (p *PipelineImpl) Run(ctx context.Context) (*Summary, error) {
+	summary, err := p.run(ctx)
+	recordRun(summary, err)
+	return summary, err
+}
+
+// recordRun records the counts of summary and whether the run failed
+func recordRun(summary *Summary, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	metrics.Add(metrics.IngestRunsTotal, 1, metrics.L("result", result))
+
+	for _, count := range []struct {
+		result string
+		value  int
+	}{
+		{"fetched", summary.Fetched},
+		{"new", summary.New},
+		{"updated", summary.Updated},
+		{"unchanged", summary.Unchanged},
+		{"duplicate", summary.Duplicates},
+		{"merged", summary.Merged},
+		{"expired", summary.Expired},
+		{"failed", summary.Failed},
+	} {
+		metrics.Add(metrics.IngestJobsTotal, float64(count.value), metrics.L("result", count.result))
+	}
+	for _, source := range summary.FailedSources {
+		metrics.Add(metrics.IngestSourceErrorsTotal, 1, metrics.L("source", source))
+	}
+}
+
+// run fetches, normalizes, dedupes and stores the jobs of every source
+func (p *PipelineImpl) run(ctx context.Context) (*Summary, error) {
+	summary := &Summary{StartedAt: p.now()}
+
+	// Sources are independent, so they are fetched concurrently and processed in order
+	results := make([]fetchResult, len(p.sources))
+	var wg sync.WaitGroup
+	for i, src := range p.sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			jobs, err := src.GetJobs(ctx)
+			results[i] = fetchResult{jobs: jobs, err: err}
+		}()
+	}
+	wg.Wait()
+
+	// seen dedupes job IDs across sources; candidates are the valid, unexpired jobs in source order
+	seen := make(map[string]bool)
+	var candidates []model.Job
+	var errs []error
+	for i, src := range p.sources {
+		result := results[i]
+		if result.err != nil {
+			logger.Error(ctx, "Failed to fetch jobs from source",
+				zap.String("source", src.Name()), zap.String("error_code", string(apperr.KindOf(result.err))), zap.Error(result.err))
+			summary.FailedSources = append(summary.FailedSources, src.Name())
+			errs = append(errs, result.err)
+			continue
+		}
+		summary.Fetched += len(result.jobs)
+
+		for _, job := range result.jobs {
+			if seen[job.ID] {
+				summary.Duplicates++
+				continue
+			}
+			seen[job.ID] = true
+			if p.accept(ctx, src, &job, summary) {
+				candidates = append(candidates, job)
+			}
+		}
+	}
+
+	if len(p.sources) > 0 && len(errs) == len(p.sources) {
+		summary.Duration = time.Since(summary.StartedAt)
+		return summary, errors.Join(errs...)
+	}
+
+	if p.dedup != nil {
+		var report dedup.Report
+		candidates, report = p.dedup.Deduplicate(candidates)
+		summary.Merged = report.Merged
+		p.mu.Lock()
+		p.report = &report
+		p.mu.Unlock()
+	}
+
+	// live holds the jobs that stay listed after this run
+	live := make(map[string]bool, len(candidates))
+	for _, job := range candidates {
+		p.store(ctx, job, summary)
+		live[job.ID] = true
+	}
+
+	// A failed source could still be listing its jobs, so nothing is expired unless every source answered. A run that
+	// leaves no job listed, as one without sources does, is more likely a broken upstream than an empty market, so it
+	// expires nothing either.
+	if len(errs) == 0 && len(live) > 0 {
+		if err := p.expireUnseen(ctx, live, summary); err != nil {
+			return summary, err
+		}
+	}
+
+	summary.Duration = time.Since(summary.StartedAt)
+	logger.Info(ctx, "Ingestion run finished",
+		zap.Int("fetched", summary.Fetched), zap.Int("new", summary.New), zap.Int("updated", summary.Updated),
+		zap.Int("unchanged", summary.Unchanged), zap.Int("duplicates", summary.Duplicates), zap.Int("merged", summary.Merged), zap.Int("expired", summary.Expired),
+		zap.Int("failed", summary.Failed), zap.Strings("failed_sources", summary.FailedSources), zap.Duration("duration", summary.Duration))
+	return summary, nil
+}
+
+// accept normalizes and validates a single job. It reports whether the job is still listed, i.e. valid and not expired.
+func (p *PipelineImpl) accept(ctx context.Context, src Source, job *model.Job, summary *Summary) bool {
+	p.prepare(src, job)
+	if err := job.Validate(); err != nil {
+		logger.Warn(ctx, "Skipping invalid job from source", zap.String("source", src.Name()), zap.String("job_id", job.ID), zap.Error(err))
+		summary.Failed++
+		return false
+	}
+	// Expired jobs are left for expireUnseen to delete
+	return !p.expired(*job)
+}
+
+// store upserts a single job, counting the outcome in summary.
+// A job that cannot be stored is still listed upstream, so a stored copy of it is kept.
+func (p *PipelineImpl) store(ctx context.Context, job model.Job, summary *Summary) {
+	result, err := p.repo.Upsert(ctx, job)
+	if err != nil {
+		logger.Error(ctx, "Failed to store job", zap.String("source", job.Source), zap.String("job_id", job.ID), zap.Error(err))
+		summary.Failed++
+		return
+	}
+	switch result {
+	case repository.Created:
+		summary.New++
+	case repository.Updated:
+		summary.Updated++
+	default:
+		summary.Unchanged++
+	}
+}
+
+// expireUnseen deletes stored jobs that are not live after this run or whose ExpiresAt has passed
+func (p *PipelineImpl) expireUnseen(ctx context.Context, live map[string]bool, summary *Summary) error {
+	stored, err := p.repo.ListUpdatedSince(ctx, time.Time{})
+	if err != nil {
+		return err
+	}
+	for _, job := range stored {
+		if live[job.ID] && !p.expired(job) {
+			continue
+		}
+		if err := p.repo.Delete(ctx, job.ID); err != nil && !apperr.Is(err, apperr.NotFound) {
+			return err
+		}
+		summary.Expired++
+	}
+	return nil
+}
+
+// FetchJob fetches a single job by ID from the source named by its prefix, "{source}:{upstream ID}". An ID without a
+// known prefix is only looked up when there is a single source, whose upstream IDs may not be namespaced; otherwise
+// no upstream is asked. Jobs not found are remembered for missTTL, so that repeated lookups of an unknown ID do not
+// reach the upstream every time. A job the listings would skip, being invalid or expired, is not found either.
+func (p *PipelineImpl) FetchJob(ctx context.Context, id string) (*model.Job, error) {
+	src := p.sourceFor(id)
+	if src == nil || p.missed(id) {
+		return nil, apperr.New(apperr.NotFound, fmt.Sprintf("job %q not found", id))
+	}
+
+	job, err := src.GetJob(ctx, id)
+	if err == nil && (job == nil || !p.accept(ctx, src, job, &Summary{})) {
+		err = apperr.New(apperr.NotFound, fmt.Sprintf("job %q not found", id))
+	}
+	if err != nil {
+		if apperr.Is(err, apperr.NotFound) {
+			p.recordMiss(id)
+		}
+		return nil, err
+	}
+
+	if _, err := p.repo.Upsert(ctx, *job); err != nil {
+		logger.Warn(ctx, "Failed to store job fetched from source", zap.String("source", src.Name()), zap.String("job_id", id), zap.Error(err))
+	}
+	return job, nil
+}
+
+// sourceFor returns the source that owns id, or nil when no source does
+func (p *PipelineImpl) sourceFor(id string) Source {
+	for _, src := range p.sources {
+		if strings.HasPrefix(id, src.Name()+":") {
+			return src
+		}
+	}
+	if len(p.sources) == 1 {
+		return p.sources[0]
+	}
+	return nil
+}
+
+// missed reports whether id was not found less than missTTL ago
+func (p *PipelineImpl) missed(id string) bool {
+	p.missMu.Lock()
+	defer p.missMu.Unlock()
+	at, ok := p.misses[id]
+	return ok && p.now().Sub(at) < missTTL
+}
+
+// recordMiss remembers that id was not found, dropping expired misses so that the map stays small
+func (p *PipelineImpl) recordMiss(id string) {
+	p.missMu.Lock()
+	defer p.missMu.Unlock()
+	now := p.now()
+	for k, at := range p.misses {
+		if now.Sub(at) >= missTTL {
+			delete(p.misses, k)
+		}
+	}
+	p.misses[id] = now
+}
+
+// DedupReport returns the merge decisions of the last successful run
+func (p *PipelineImpl) DedupReport() *dedup.Report {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.report
+}
+
+// Breakers returns the circuit breaker state of the sources that are guarded by one, in source order
+func (p *PipelineImpl) Breakers() []httpclient.BreakerStatus {
+	statuses := make([]httpclient.BreakerStatus, 0, len(p.sources))
+	for _, src := range p.sources {
+		// The breaker may sit below other decorators, such as a cache that serves stale jobs while it is open
+		var client httpclient.HttpClient = src
+		for client != nil {
+			if b, ok := client.(breakerSource); ok {
+				statuses = append(statuses, b.BreakerStatus())
+				break
+			}
+			w, ok := client.(wrapper)
+			if !ok {
+				break
+			}
+			client = w.Unwrap()
+		}
+	}
+	return statuses
+}
+
+// prepare applies the normalizers and records the source of job
+func (p *PipelineImpl) prepare(src Source, job *model.Job) {
+	if job.Source == "" {
+		job.Source = src.Name()
+	}
+	for _, n := range p.normalizers {
+		n.Normalize(job)
+	}
+}
+
+// expired reports whether the job's posting period is over
+func (p *PipelineImpl) expired(job model.Job) bool {
+	return !job.ExpiresAt.IsZero() && job.ExpiresAt.Before(p.now())
+}
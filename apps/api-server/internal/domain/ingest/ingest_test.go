@@ -0,0 +1,469 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/dedup"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	mock_httpclient "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient/mock"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/jobstore"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics/metricstest"
+	"go.uber.org/mock/gomock"
+)
+
+var testNow = time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+
+// salaryTextNormalizer is a Normalizer that sets a fixed annual salary on jobs with salary text
+type salaryTextNormalizer struct{}
+
+func (salaryTextNormalizer) Normalize(job *model.Job) {
+	if job.SalaryText != "" {
+		job.SalaryMin, job.SalaryMax, job.SalaryConfidence = 8000000, 9000000, 1
+	}
+}
+
+func TestPipelineImpl_Run(t *testing.T) {
+	tests := []struct {
+		name            string
+		stored          []model.Job
+		mockSetup       func(a, b *mock_httpclient.MockHttpClient)
+		expectedSummary Summary
+		expectedIDs     []string
+		expectError     bool
+	}{
+		{
+			name:   "Success: New, updated and unchanged jobs are counted",
+			stored: []model.Job{{ID: "1", Title: "Backend Engineer", Source: "a"}, {ID: "2", Title: "Old Title", Source: "a"}},
+			mockSetup: func(a, b *mock_httpclient.MockHttpClient) {
+				a.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{
+					{ID: "1", Title: "Backend Engineer"},
+					{ID: "2", Title: "Frontend Engineer"},
+				}, nil)
+				b.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{{ID: "3", Title: "SRE"}}, nil)
+			},
+			expectedSummary: Summary{Fetched: 3, New: 1, Updated: 1, Unchanged: 1},
+			expectedIDs:     []string{"1", "2", "3"},
+		},
+		{
+			name: "Success: Duplicates across sources are kept from the first source",
+			mockSetup: func(a, b *mock_httpclient.MockHttpClient) {
+				a.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{{ID: "1", Title: "Backend Engineer"}}, nil)
+				b.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{{ID: "1", Title: "Backend Engineer (b)"}, {ID: "2", Title: "SRE"}}, nil)
+			},
+			expectedSummary: Summary{Fetched: 3, New: 2, Duplicates: 1},
+			expectedIDs:     []string{"1", "2"},
+		},
+		{
+			name:   "Success: Unlisted and expired jobs are deleted",
+			stored: []model.Job{{ID: "1", Title: "Backend Engineer", Source: "a"}, {ID: "2", Title: "Frontend Engineer", Source: "a"}},
+			mockSetup: func(a, b *mock_httpclient.MockHttpClient) {
+				a.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{
+					{ID: "1", Title: "Backend Engineer", ExpiresAt: testNow.Add(-time.Hour)},
+					{ID: "3", Title: "SRE", ExpiresAt: testNow.Add(time.Hour)},
+				}, nil)
+				b.EXPECT().GetJobs(gomock.Any()).Return(nil, nil)
+			},
+			expectedSummary: Summary{Fetched: 2, New: 1, Expired: 2},
+			expectedIDs:     []string{"3"},
+		},
+		{
+			name: "Success: Invalid jobs are counted as failed",
+			mockSetup: func(a, b *mock_httpclient.MockHttpClient) {
+				a.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{{ID: "1", Title: "Backend Engineer"}, {ID: "2"}}, nil)
+				b.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{{ID: "3", Title: "SRE", EmploymentType: "seasonal"}}, nil)
+			},
+			expectedSummary: Summary{Fetched: 3, New: 1, Failed: 2},
+			expectedIDs:     []string{"1"},
+		},
+		{
+			name:   "Success: A failed source keeps stored jobs from being expired",
+			stored: []model.Job{{ID: "1", Title: "Backend Engineer", Source: "b"}},
+			mockSetup: func(a, b *mock_httpclient.MockHttpClient) {
+				a.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{{ID: "2", Title: "SRE"}}, nil)
+				b.EXPECT().GetJobs(gomock.Any()).Return(nil, apperr.New(apperr.UpstreamUnavailable, "upstream returned status 503"))
+			},
+			expectedSummary: Summary{Fetched: 1, New: 1, FailedSources: []string{"b"}},
+			expectedIDs:     []string{"1", "2"},
+		},
+		{
+			name:   "Success: A run listing no job keeps stored jobs from being expired",
+			stored: []model.Job{{ID: "1", Title: "Backend Engineer", Source: "a"}},
+			mockSetup: func(a, b *mock_httpclient.MockHttpClient) {
+				a.EXPECT().GetJobs(gomock.Any()).Return(nil, nil)
+				b.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{{ID: "2"}}, nil)
+			},
+			expectedSummary: Summary{Fetched: 1, Failed: 1},
+			expectedIDs:     []string{"1"},
+		},
+		{
+			name:   "Error: Every source failing is an error",
+			stored: []model.Job{{ID: "1", Title: "Backend Engineer", Source: "a"}},
+			mockSetup: func(a, b *mock_httpclient.MockHttpClient) {
+				a.EXPECT().GetJobs(gomock.Any()).Return(nil, errors.New("network timeout"))
+				b.EXPECT().GetJobs(gomock.Any()).Return(nil, apperr.New(apperr.UpstreamTimeout, "upstream request timed out"))
+			},
+			expectedSummary: Summary{FailedSources: []string{"a", "b"}},
+			expectedIDs:     []string{"1"},
+			expectError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			recorder := metricstest.Setup(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			a := mock_httpclient.NewMockHttpClient(ctrl)
+			b := mock_httpclient.NewMockHttpClient(ctrl)
+			tt.mockSetup(a, b)
+
+			repo := jobstore.NewMemory()
+			for _, job := range tt.stored {
+				if _, err := repo.Upsert(context.Background(), job); err != nil {
+					t.Fatalf("Failed to seed job: %v", err)
+				}
+			}
+			p := newTestPipeline(repo, NamedSource("a", a), NamedSource("b", b))
+
+			// Act
+			summary, err := p.Run(context.Background())
+
+			// Assert
+			if tt.expectError != (err != nil) {
+				t.Fatalf("Expected error: %v, got %v", tt.expectError, err)
+			}
+			if summary == nil {
+				t.Fatal("Expected a summary, got nil")
+			}
+			if !summary.StartedAt.Equal(testNow) {
+				t.Errorf("Expected StartedAt %v, got %v", testNow, summary.StartedAt)
+			}
+			summary.StartedAt, summary.Duration = time.Time{}, 0
+			if !reflect.DeepEqual(*summary, tt.expectedSummary) {
+				t.Errorf("Summary mismatch:\n  expected: %+v\n  got:      %+v", tt.expectedSummary, *summary)
+			}
+			assertStoredIDs(t, repo, tt.expectedIDs...)
+			assertRunMetrics(t, recorder, tt.expectedSummary, tt.expectError)
+		})
+	}
+}
+
+func TestPipelineImpl_Run_NoSources(t *testing.T) {
+	// Arrange: Sourceが1つも設定されていない
+	repo := jobstore.NewMemory()
+	if _, err := repo.Upsert(context.Background(), model.Job{ID: "1", Title: "Backend Engineer"}); err != nil {
+		t.Fatalf("Failed to seed job: %v", err)
+	}
+	p := newTestPipeline(repo)
+
+	// Act
+	summary, err := p.Run(context.Background())
+
+	// Assert: 保存済みのJobは失効しない
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if summary.Expired != 0 {
+		t.Errorf("Expected no expired job, got %d", summary.Expired)
+	}
+	assertStoredIDs(t, repo, "1")
+}
+
+// assertRunMetrics checks that the counts of summary and the result of the run were recorded
+func assertRunMetrics(t *testing.T, recorder *metricstest.Recorder, summary Summary, failed bool) {
+	t.Helper()
+
+	result := "success"
+	if failed {
+		result = "failure"
+	}
+	if got := recorder.Counter(metrics.IngestRunsTotal, metrics.L("result", result)); got != 1 {
+		t.Errorf("Expected 1 %s run, got %v", result, got)
+	}
+	for label, expected := range map[string]int{"fetched": summary.Fetched, "new": summary.New, "failed": summary.Failed, "expired": summary.Expired} {
+		if got := recorder.Counter(metrics.IngestJobsTotal, metrics.L("result", label)); got != float64(expected) {
+			t.Errorf("Expected %d %s jobs, got %v", expected, label, got)
+		}
+	}
+	for _, source := range summary.FailedSources {
+		if got := recorder.Counter(metrics.IngestSourceErrorsTotal, metrics.L("source", source)); got != 1 {
+			t.Errorf("Expected 1 error of source %s, got %v", source, got)
+		}
+	}
+}
+
+func TestPipelineImpl_Run_Prepare(t *testing.T) {
+	// Arrange: 給与が原文でしか与えられていないJobと、上流がSourceを持つJob
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+	mockClient.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{
+		{ID: "1", Title: "Backend Engineer", SalaryText: "年収800万円〜900万円"},
+		{ID: "2", Title: "Frontend Engineer", Source: "partner"},
+	}, nil)
+	repo := jobstore.NewMemory()
+	p := newTestPipeline(repo, NamedSource("a", mockClient))
+
+	// Act
+	if _, err := p.Run(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Assert: 正規化された年収とSourceが保存される
+	job, err := repo.Get(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Expected job 1 to be stored, got %v", err)
+	}
+	if job.SalaryMin != 8000000 || job.SalaryMax != 9000000 {
+		t.Errorf("Expected normalized salary 8000000-9000000, got %d-%d", job.SalaryMin, job.SalaryMax)
+	}
+	if job.Source != "a" {
+		t.Errorf("Expected source 'a', got '%s'", job.Source)
+	}
+	job, err = repo.Get(context.Background(), "2")
+	if err != nil {
+		t.Fatalf("Expected job 2 to be stored, got %v", err)
+	}
+	if job.Source != "partner" {
+		t.Errorf("Expected upstream source 'partner' to be kept, got '%s'", job.Source)
+	}
+}
+
+func TestPipelineImpl_Run_Dedup(t *testing.T) {
+	// Arrange: ATSとRSSに同じ求人が別IDで掲載されている。前回はRSS側のIDで保存済み
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	description := "Go と AWS を使った決済基盤の開発。マイクロサービスの設計から運用まで担当していただきます。"
+	ats := mock_httpclient.NewMockHttpClient(ctrl)
+	ats.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{
+		{ID: "ats:1", Title: "Backend Engineer", Company: "株式会社アクメ", Location: "東京都", Description: description, EmploymentType: model.EmploymentFullTime, RemotePolicy: model.RemoteHybrid, ApplyURL: "https://ats.example/1"},
+	}, nil)
+	feed := mock_httpclient.NewMockHttpClient(ctrl)
+	feed.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{
+		{ID: "feed:9", Title: "【急募】Backend Engineer", Company: "アクメ", Description: description, SalaryText: "年収800万円〜900万円", ApplyURL: "https://feed.example/9"},
+	}, nil)
+
+	repo := jobstore.NewMemory()
+	if _, err := repo.Upsert(context.Background(), model.Job{ID: "feed:9", Title: "Backend Engineer"}); err != nil {
+		t.Fatalf("Failed to seed job: %v", err)
+	}
+	p := NewPipeline(repo, []Source{NamedSource("ats", ats), NamedSource("feed", feed)}, dedup.New(dedup.Config{MaxDistance: 3}), salaryTextNormalizer{}).(*PipelineImpl)
+	p.now = func() time.Time { return testNow }
+
+	// Act
+	summary, err := p.Run(context.Background())
+
+	// Assert: 1件にまとめられ、RSS側のIDは削除される
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if summary.Merged != 1 || summary.New != 1 || summary.Expired != 1 {
+		t.Errorf("Expected 1 merged, 1 new and 1 expired job, got %+v", *summary)
+	}
+	assertStoredIDs(t, repo, "ats:1")
+	job, err := repo.Get(context.Background(), "ats:1")
+	if err != nil {
+		t.Fatalf("Expected the merged job to be stored, got %v", err)
+	}
+	if job.SalaryMax != 9000000 {
+		t.Errorf("Expected the salary of the feed posting to be merged in, got %d", job.SalaryMax)
+	}
+	if !reflect.DeepEqual(job.SourceURLs, []string{"https://ats.example/1", "https://feed.example/9"}) {
+		t.Errorf("Expected both source URLs, got %v", job.SourceURLs)
+	}
+	report := p.DedupReport()
+	if report == nil || len(report.Clusters) != 1 || report.Clusters[0].CanonicalID != "ats:1" {
+		t.Fatalf("Expected one cluster for ats:1, got %+v", report)
+	}
+}
+
+func TestPipelineImpl_FetchJob(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		single       bool // only source "a" is configured
+		mockSetup    func(a, b *mock_httpclient.MockHttpClient)
+		expectedJob  *model.Job
+		expectStored bool
+		expectedKind apperr.Kind
+	}{
+		{
+			name: "Success: Only the source named by the ID prefix is asked",
+			id:   "b:1",
+			mockSetup: func(a, b *mock_httpclient.MockHttpClient) {
+				b.EXPECT().GetJob(gomock.Any(), "b:1").Return(&model.Job{ID: "b:1", Title: "Backend Engineer", SalaryText: "年収800万円〜900万円"}, nil)
+			},
+			expectedJob:  &model.Job{ID: "b:1", Title: "Backend Engineer", Source: "b", SalaryText: "年収800万円〜900万円", SalaryMin: 8000000, SalaryMax: 9000000, SalaryConfidence: 1},
+			expectStored: true,
+		},
+		{
+			name:   "Success: ID without a prefix is asked of a single source",
+			id:     "1",
+			single: true,
+			mockSetup: func(a, b *mock_httpclient.MockHttpClient) {
+				a.EXPECT().GetJob(gomock.Any(), "1").Return(&model.Job{ID: "1", Title: "Backend Engineer"}, nil)
+			},
+			expectedJob:  &model.Job{ID: "1", Title: "Backend Engineer", Source: "a"},
+			expectStored: true,
+		},
+		{
+			name:         "Error: ID without a known prefix asks no source",
+			id:           "c:1",
+			mockSetup:    func(a, b *mock_httpclient.MockHttpClient) {},
+			expectedKind: apperr.NotFound,
+		},
+		{
+			name: "Error: Not found in the source",
+			id:   "a:1",
+			mockSetup: func(a, b *mock_httpclient.MockHttpClient) {
+				a.EXPECT().GetJob(gomock.Any(), "a:1").Return(nil, apperr.New(apperr.NotFound, "job not found"))
+			},
+			expectedKind: apperr.NotFound,
+		},
+		{
+			name: "Error: Source answering without a job is not found",
+			id:   "a:1",
+			mockSetup: func(a, b *mock_httpclient.MockHttpClient) {
+				a.EXPECT().GetJob(gomock.Any(), "a:1").Return(nil, nil)
+			},
+			expectedKind: apperr.NotFound,
+		},
+		{
+			name: "Error: Invalid job is not found",
+			id:   "a:1",
+			mockSetup: func(a, b *mock_httpclient.MockHttpClient) {
+				a.EXPECT().GetJob(gomock.Any(), "a:1").Return(&model.Job{ID: "a:1"}, nil)
+			},
+			expectedKind: apperr.NotFound,
+		},
+		{
+			name: "Error: Expired job is not found",
+			id:   "a:1",
+			mockSetup: func(a, b *mock_httpclient.MockHttpClient) {
+				a.EXPECT().GetJob(gomock.Any(), "a:1").Return(&model.Job{ID: "a:1", Title: "Backend Engineer", ExpiresAt: testNow.Add(-time.Hour)}, nil)
+			},
+			expectedKind: apperr.NotFound,
+		},
+		{
+			name: "Error: Open circuit is not reported as not found",
+			id:   "a:1",
+			mockSetup: func(a, b *mock_httpclient.MockHttpClient) {
+				a.EXPECT().GetJob(gomock.Any(), "a:1").Return(nil, apperr.Wrap(apperr.UpstreamUnavailable, httpclient.ErrCircuitOpen, ""))
+			},
+			expectedKind: apperr.UpstreamUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			a := mock_httpclient.NewMockHttpClient(ctrl)
+			b := mock_httpclient.NewMockHttpClient(ctrl)
+			tt.mockSetup(a, b)
+
+			repo := jobstore.NewMemory()
+			sources := []Source{NamedSource("a", a), NamedSource("b", b)}
+			if tt.single {
+				sources = sources[:1]
+			}
+			p := newTestPipeline(repo, sources...)
+
+			// Act
+			job, err := p.FetchJob(context.Background(), tt.id)
+
+			// Assert
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(job, tt.expectedJob) {
+				t.Errorf("Job mismatch:\n  expected: %+v\n  got:      %+v", *tt.expectedJob, *job)
+			}
+			_, err = repo.Get(context.Background(), tt.id)
+			if stored := err == nil; stored != tt.expectStored {
+				t.Errorf("Expected stored: %v, got %v", tt.expectStored, stored)
+			}
+		})
+	}
+}
+
+func TestPipelineImpl_FetchJob_Miss(t *testing.T) {
+	// Arrange: 見つからなかった ID は missTTL の間、上流に問い合わせない
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	a := mock_httpclient.NewMockHttpClient(ctrl)
+	a.EXPECT().GetJob(gomock.Any(), "a:1").Return(nil, apperr.New(apperr.NotFound, "job not found")).Times(2)
+
+	p := newTestPipeline(jobstore.NewMemory(), NamedSource("a", a))
+	now := testNow
+	p.now = func() time.Time { return now }
+
+	// Act & Assert
+	for _, after := range []time.Duration{0, missTTL - time.Second, missTTL} {
+		now = testNow.Add(after)
+		if _, err := p.FetchJob(context.Background(), "a:1"); !apperr.Is(err, apperr.NotFound) {
+			t.Fatalf("Expected not found after %s, got %v", after, err)
+		}
+	}
+}
+
+// newTestPipeline creates a PipelineImpl with a fixed clock and the salaryTextNormalizer
+func newTestPipeline(repo *jobstore.MemoryStore, sources ...Source) *PipelineImpl {
+	p := NewPipeline(repo, sources, nil, salaryTextNormalizer{}).(*PipelineImpl)
+	p.now = func() time.Time { return testNow }
+	return p
+}
+
+func assertStoredIDs(t *testing.T, repo *jobstore.MemoryStore, expected ...string) {
+	t.Helper()
+	jobs, err := repo.Query(context.Background(), model.JobQuery{})
+	if err != nil {
+		t.Fatalf("Failed to query jobs: %v", err)
+	}
+	ids := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, job.ID)
+	}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Expected stored jobs %v, got %v", expected, ids)
+	}
+}
+
+func TestPipelineImpl_Breakers(t *testing.T) {
+	// Arrange: "a" だけがサーキットブレーカー付きで、その上にキャッシュがある
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	breaker := httpclient.NewBreakerClient("a", mock_httpclient.NewMockHttpClient(ctrl), httpclient.BreakerConfig{FailureThreshold: 1})
+	a := NamedSource("a", httpclient.NewCachingClient(breaker, httpclient.CacheConfig{TTL: time.Minute}))
+	b := NamedSource("b", mock_httpclient.NewMockHttpClient(ctrl))
+	p := newTestPipeline(jobstore.NewMemory(), a, b)
+
+	// Act
+	statuses := p.Breakers()
+
+	// Assert
+	if len(statuses) != 1 || statuses[0].Name != "a" || statuses[0].State != httpclient.BreakerClosed {
+		t.Errorf("Expected the closed breaker of a only, got %+v", statuses)
+	}
+}
@@ -0,0 +1,284 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ingest.go
+//
+// Generated by this command:
+//
+//	mockgen -source=ingest.go -destination=mock/mock_ingest.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dedup "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/dedup"
+	ingest "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest"
+	model "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	httpclient "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSource is a mock of Source interface.
+type MockSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceMockRecorder
+	isgomock struct{}
+}
+
+// MockSourceMockRecorder is the mock recorder for MockSource.
+type MockSourceMockRecorder struct {
+	mock *MockSource
+}
+
+// NewMockSource creates a new mock instance.
+func NewMockSource(ctrl *gomock.Controller) *MockSource {
+	mock := &MockSource{ctrl: ctrl}
+	mock.recorder = &MockSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSource) EXPECT() *MockSourceMockRecorder {
+	return m.recorder
+}
+
+// GetJob mocks base method.
+func (m *MockSource) GetJob(ctx context.Context, id string) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, id)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockSourceMockRecorder) GetJob(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockSource)(nil).GetJob), ctx, id)
+}
+
+// GetJobs mocks base method.
+func (m *MockSource) GetJobs(ctx context.Context) ([]model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobs", ctx)
+	ret0, _ := ret[0].([]model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobs indicates an expected call of GetJobs.
+func (mr *MockSourceMockRecorder) GetJobs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobs", reflect.TypeOf((*MockSource)(nil).GetJobs), ctx)
+}
+
+// Name mocks base method.
+func (m *MockSource) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockSourceMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockSource)(nil).Name))
+}
+
+// MockNormalizer is a mock of Normalizer interface.
+type MockNormalizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNormalizerMockRecorder
+	isgomock struct{}
+}
+
+// MockNormalizerMockRecorder is the mock recorder for MockNormalizer.
+type MockNormalizerMockRecorder struct {
+	mock *MockNormalizer
+}
+
+// NewMockNormalizer creates a new mock instance.
+func NewMockNormalizer(ctrl *gomock.Controller) *MockNormalizer {
+	mock := &MockNormalizer{ctrl: ctrl}
+	mock.recorder = &MockNormalizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNormalizer) EXPECT() *MockNormalizerMockRecorder {
+	return m.recorder
+}
+
+// Normalize mocks base method.
+func (m *MockNormalizer) Normalize(job *model.Job) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Normalize", job)
+}
+
+// Normalize indicates an expected call of Normalize.
+func (mr *MockNormalizerMockRecorder) Normalize(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Normalize", reflect.TypeOf((*MockNormalizer)(nil).Normalize), job)
+}
+
+// MockPipeline is a mock of Pipeline interface.
+type MockPipeline struct {
+	ctrl     *gomock.Controller
+	recorder *MockPipelineMockRecorder
+	isgomock struct{}
+}
+
+// MockPipelineMockRecorder is the mock recorder for MockPipeline.
+type MockPipelineMockRecorder struct {
+	mock *MockPipeline
+}
+
+// NewMockPipeline creates a new mock instance.
+func NewMockPipeline(ctrl *gomock.Controller) *MockPipeline {
+	mock := &MockPipeline{ctrl: ctrl}
+	mock.recorder = &MockPipelineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPipeline) EXPECT() *MockPipelineMockRecorder {
+	return m.recorder
+}
+
+// Breakers mocks base method.
+func (m *MockPipeline) Breakers() []httpclient.BreakerStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Breakers")
+	ret0, _ := ret[0].([]httpclient.BreakerStatus)
+	return ret0
+}
+
+// Breakers indicates an expected call of Breakers.
+func (mr *MockPipelineMockRecorder) Breakers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Breakers", reflect.TypeOf((*MockPipeline)(nil).Breakers))
+}
+
+// DedupReport mocks base method.
+func (m *MockPipeline) DedupReport() *dedup.Report {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedupReport")
+	ret0, _ := ret[0].(*dedup.Report)
+	return ret0
+}
+
+// DedupReport indicates an expected call of DedupReport.
+func (mr *MockPipelineMockRecorder) DedupReport() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedupReport", reflect.TypeOf((*MockPipeline)(nil).DedupReport))
+}
+
+// FetchJob mocks base method.
+func (m *MockPipeline) FetchJob(ctx context.Context, id string) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchJob", ctx, id)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJob indicates an expected call of FetchJob.
+func (mr *MockPipelineMockRecorder) FetchJob(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJob", reflect.TypeOf((*MockPipeline)(nil).FetchJob), ctx, id)
+}
+
+// Run mocks base method.
+func (m *MockPipeline) Run(ctx context.Context) (*ingest.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx)
+	ret0, _ := ret[0].(*ingest.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockPipelineMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockPipeline)(nil).Run), ctx)
+}
+
+// MockbreakerSource is a mock of breakerSource interface.
+type MockbreakerSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockbreakerSourceMockRecorder
+	isgomock struct{}
+}
+
+// MockbreakerSourceMockRecorder is the mock recorder for MockbreakerSource.
+type MockbreakerSourceMockRecorder struct {
+	mock *MockbreakerSource
+}
+
+// NewMockbreakerSource creates a new mock instance.
+func NewMockbreakerSource(ctrl *gomock.Controller) *MockbreakerSource {
+	mock := &MockbreakerSource{ctrl: ctrl}
+	mock.recorder = &MockbreakerSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockbreakerSource) EXPECT() *MockbreakerSourceMockRecorder {
+	return m.recorder
+}
+
+// BreakerStatus mocks base method.
+func (m *MockbreakerSource) BreakerStatus() httpclient.BreakerStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BreakerStatus")
+	ret0, _ := ret[0].(httpclient.BreakerStatus)
+	return ret0
+}
+
+// BreakerStatus indicates an expected call of BreakerStatus.
+func (mr *MockbreakerSourceMockRecorder) BreakerStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BreakerStatus", reflect.TypeOf((*MockbreakerSource)(nil).BreakerStatus))
+}
+
+// MockDeduplicator is a mock of Deduplicator interface.
+type MockDeduplicator struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeduplicatorMockRecorder
+	isgomock struct{}
+}
+
+// MockDeduplicatorMockRecorder is the mock recorder for MockDeduplicator.
+type MockDeduplicatorMockRecorder struct {
+	mock *MockDeduplicator
+}
+
+// NewMockDeduplicator creates a new mock instance.
+func NewMockDeduplicator(ctrl *gomock.Controller) *MockDeduplicator {
+	mock := &MockDeduplicator{ctrl: ctrl}
+	mock.recorder = &MockDeduplicatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeduplicator) EXPECT() *MockDeduplicatorMockRecorder {
+	return m.recorder
+}
+
+// Deduplicate mocks base method.
+func (m *MockDeduplicator) Deduplicate(jobs []model.Job) ([]model.Job, dedup.Report) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deduplicate", jobs)
+	ret0, _ := ret[0].([]model.Job)
+	ret1, _ := ret[1].(dedup.Report)
+	return ret0, ret1
+}
+
+// Deduplicate indicates an expected call of Deduplicate.
+func (mr *MockDeduplicatorMockRecorder) Deduplicate(jobs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deduplicate", reflect.TypeOf((*MockDeduplicator)(nil).Deduplicate), jobs)
+}
@@ -0,0 +1,261 @@
+package location
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+)
+
+// japan.json lists the 47 prefectures and major cities with their Japanese, romaji and colloquial names
+//
+//go:embed japan.json
+var dataset []byte
+
+// Prefecture is a prefecture of the embedded dataset
+type Prefecture struct {
+	Code    string       `json:"code"` // JIS X 0401
+	Name    string       `json:"name"`
+	Romaji  string       `json:"romaji"`
+	Region  model.Region `json:"region"`
+	Aliases []string     `json:"aliases"`
+}
+
+// City is a city or Tokyo ward of the embedded dataset
+type City struct {
+	Code       string   `json:"code"` // 全国地方公共団体コード (5桁)
+	Prefecture string   `json:"prefecture"`
+	Name       string   `json:"name"`
+	Romaji     string   `json:"romaji"`
+	Aliases    []string `json:"aliases"` // 駅名や地域名など
+}
+
+var (
+	// remoteKeywords mark a location as remote; they are matched against the normalized text
+	remoteKeywords = []string{"remote", "リモート", "在宅", "テレワーク", "work from home", "wfh", "anywhere"}
+	// negatedRemote matches remote keywords that are negated, such as "リモート不可", "在宅勤務は不可能", "no remote" and
+	// "remote not available". They are removed before remoteKeywords is matched.
+	negatedRemote = regexp.MustCompile(`(?:リモート|在宅|テレワーク)(?:ワーク|勤務)?(?:は|での勤務は)?\s*(?:不可能|不可|なし|無し)` +
+		`|\b(?:no|not|non)[\s-]+remote\b` +
+		`|\bremote(?:\s+work)?(?:\s+is)?\s+not\s+(?:available|possible|allowed|offered)`)
+)
+
+// entry is what a dictionary name resolves to; city is empty for prefecture names
+type entry struct {
+	prefecture string
+	city       string
+}
+
+// Normalizer resolves free-text locations such as "東京都渋谷区" or "Shibuya, Tokyo" to a model.Place
+type Normalizer struct {
+	prefectures map[string]Prefecture
+	cities      map[string]City
+	names       map[string]entry
+	maxNameLen  int
+}
+
+// NewNormalizer creates a new Normalizer from the embedded dataset
+func NewNormalizer() (*Normalizer, error) {
+	var data struct {
+		Prefectures []Prefecture `json:"prefectures"`
+		Cities      []City       `json:"cities"`
+	}
+	if err := json.Unmarshal(dataset, &data); err != nil {
+		return nil, fmt.Errorf("failed to load location dataset: %w", err)
+	}
+
+	n := &Normalizer{
+		prefectures: make(map[string]Prefecture, len(data.Prefectures)),
+		cities:      make(map[string]City, len(data.Cities)),
+		names:       make(map[string]entry),
+	}
+	// Prefectures are registered first so that they win over a city of the same name ("Osaka", "京都")
+	for _, p := range data.Prefectures {
+		n.prefectures[p.Code] = p
+		e := entry{prefecture: p.Code}
+		n.addName(p.Name, e)
+		for _, suffix := range []string{"都", "府", "県"} {
+			if bare := strings.TrimSuffix(p.Name, suffix); bare != p.Name {
+				n.addName(bare, e)
+			}
+		}
+		n.addName(p.Romaji, e)
+		for _, alias := range p.Aliases {
+			n.addName(alias, e)
+		}
+	}
+	for _, c := range data.Cities {
+		if _, ok := n.prefectures[c.Prefecture]; !ok {
+			return nil, fmt.Errorf("city %s refers to unknown prefecture %s", c.Code, c.Prefecture)
+		}
+		n.cities[c.Code] = c
+		e := entry{prefecture: c.Prefecture, city: c.Code}
+		n.addName(c.Name, e)
+		// "横浜市" is also written "横浜"; wards are too short to strip safely ("港区" → "港")
+		if bare := strings.TrimSuffix(c.Name, "市"); bare != c.Name && utf8.RuneCountInString(bare) >= 2 {
+			n.addName(bare, e)
+		}
+		n.addName(c.Romaji, e)
+		for _, alias := range c.Aliases {
+			n.addName(alias, e)
+		}
+	}
+
+	return n, nil
+}
+
+// addName registers name unless it is already taken
+func (n *Normalizer) addName(name string, e entry) {
+	key := normalizeText(name)
+	if key == "" {
+		return
+	}
+	if _, ok := n.names[key]; ok {
+		return
+	}
+	n.names[key] = e
+	n.maxNameLen = max(n.maxNameLen, len(key))
+}
+
+// Prefecture returns the prefecture with the given JIS X 0401 code
+func (n *Normalizer) Prefecture(code string) (Prefecture, bool) {
+	p, ok := n.prefectures[code]
+	return p, ok
+}
+
+// Resolve finds the prefecture, city and remote flag mentioned in text.
+// It returns false when text mentions neither a known place nor remote work.
+func (n *Normalizer) Resolve(text string) (model.Place, bool) {
+	normalized := normalizeText(text)
+	remote := containsAny(negatedRemote.ReplaceAllString(normalized, " "), remoteKeywords)
+
+	var prefectures, cities []string
+	for _, e := range n.scan(normalized) {
+		if e.city != "" {
+			cities = append(cities, e.city)
+		} else {
+			prefectures = append(prefectures, e.prefecture)
+		}
+	}
+
+	// A city only counts when it lies in a prefecture the text mentions, so "大阪市北区" is not Tokyo's 北区
+	var city *City
+	for _, code := range cities {
+		c := n.cities[code]
+		if len(prefectures) == 0 || slices.Contains(prefectures, c.Prefecture) {
+			city = &c
+			break
+		}
+	}
+
+	var prefectureCode string
+	switch {
+	case city != nil:
+		prefectureCode = city.Prefecture
+	case len(prefectures) > 0:
+		prefectureCode = prefectures[0]
+	case !remote:
+		return model.Place{}, false
+	}
+
+	place := model.Place{Remote: remote}
+	if p, ok := n.prefectures[prefectureCode]; ok {
+		place.PrefectureCode = p.Code
+		place.Prefecture = p.Name
+		place.Region = p.Region
+	}
+	if city != nil {
+		place.CityCode = city.Code
+		place.City = city.Name
+	}
+	return place, true
+}
+
+// Normalize fills job.Place from job.Location. A place already provided by the upstream is kept.
+func (n *Normalizer) Normalize(job *model.Job) {
+	if job.Place != nil && job.Place.PrefectureCode != "" {
+		return
+	}
+	if place, ok := n.Resolve(job.Location); ok {
+		job.Place = &place
+	}
+}
+
+// scan walks text left to right and returns the longest known name at each position.
+// Taking the longest match first keeps "東京都" from also matching "京都".
+func (n *Normalizer) scan(text string) []entry {
+	var found []entry
+	for i := 0; i < len(text); {
+		matched := 0
+		for j := min(len(text), i+n.maxNameLen); j > i; j-- {
+			if j < len(text) && !utf8.RuneStart(text[j]) {
+				continue
+			}
+			e, ok := n.names[text[i:j]]
+			if !ok || !atWordBoundary(text, i, j) {
+				continue
+			}
+			found = append(found, e)
+			matched = j - i
+			break
+		}
+		if matched > 0 {
+			i += matched
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+	}
+	return found
+}
+
+// atWordBoundary reports whether text[i:j] is not part of a longer romaji word, so "kita" does not match inside "kitakyushu".
+// Japanese names have no word boundaries and always match.
+func atWordBoundary(text string, i, j int) bool {
+	if isLetter(text[i]) && i > 0 && isLetter(text[i-1]) {
+		return false
+	}
+	if isLetter(text[j-1]) && j < len(text) && isLetter(text[j]) {
+		return false
+	}
+	return true
+}
+
+// normalizeText converts full-width ASCII to half-width, drops macrons and lowercases the text
+func normalizeText(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r >= '！' && r <= '～':
+			r -= 0xFEE0
+		case r == '　':
+			r = ' '
+		case r == 'ō' || r == 'ô' || r == 'Ō':
+			r = 'o'
+		case r == 'ū' || r == 'û' || r == 'Ū':
+			r = 'u'
+		case r == 'ā' || r == 'Ā':
+			r = 'a'
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToLower(strings.TrimSpace(b.String()))
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+func containsAny(s string, keywords []string) bool {
+	for _, k := range keywords {
+		if strings.Contains(s, k) {
+			return true
+		}
+	}
+	return false
+}
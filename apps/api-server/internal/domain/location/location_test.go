@@ -0,0 +1,191 @@
+package location
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+)
+
+func TestNewNormalizer(t *testing.T) {
+	// Act
+	n, err := NewNormalizer()
+
+	// Assert: 47都道府県がすべて読み込まれていること
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(n.prefectures) != 47 {
+		t.Errorf("Expected 47 prefectures, got %d", len(n.prefectures))
+	}
+	for code, p := range n.prefectures {
+		if !model.ValidPrefectureCode(code) {
+			t.Errorf("Prefecture %s has an invalid code", p.Name)
+		}
+		if p.Region == "" || p.Romaji == "" {
+			t.Errorf("Prefecture %s is missing its region or romaji name", code)
+		}
+	}
+	for code, c := range n.cities {
+		if len(code) != 5 || code[:2] != c.Prefecture {
+			t.Errorf("City %s has code %s that does not belong to prefecture %s", c.Name, code, c.Prefecture)
+		}
+	}
+}
+
+func TestNormalizer_Resolve(t *testing.T) {
+	tokyo := model.Place{PrefectureCode: "13", Prefecture: "東京都", Region: model.RegionKanto}
+	shibuya := model.Place{PrefectureCode: "13", Prefecture: "東京都", CityCode: "13113", City: "渋谷区", Region: model.RegionKanto}
+	osakaCity := model.Place{PrefectureCode: "27", Prefecture: "大阪府", CityCode: "27100", City: "大阪市", Region: model.RegionKinki}
+
+	tests := []struct {
+		name          string
+		text          string
+		expectedPlace model.Place
+		expectedOK    bool
+	}{
+		{name: "Japanese prefecture and ward", text: "東京都渋谷区", expectedPlace: shibuya, expectedOK: true},
+		{name: "Romaji with country", text: "Tokyo, Japan", expectedPlace: tokyo, expectedOK: true},
+		{name: "Romaji ward only", text: "Shibuya", expectedPlace: shibuya, expectedOK: true},
+		{name: "Romaji ward with suffix and prefecture", text: "Shibuya-ku, Tokyo", expectedPlace: shibuya, expectedOK: true},
+		{name: "Macrons are ignored", text: "Tōkyō", expectedPlace: tokyo, expectedOK: true},
+		{name: "Full-width romaji", text: "ＴＯＫＹＯ", expectedPlace: tokyo, expectedOK: true},
+		{name: "Bare prefecture name", text: "東京", expectedPlace: tokyo, expectedOK: true},
+		{name: "東京都 is not read as 京都", text: "東京都", expectedPlace: tokyo, expectedOK: true},
+		{
+			name:          "京都府 resolves to Kyoto",
+			text:          "京都府",
+			expectedPlace: model.Place{PrefectureCode: "26", Prefecture: "京都府", Region: model.RegionKinki},
+			expectedOK:    true,
+		},
+		{
+			name:          "Neighbourhood alias",
+			text:          "六本木オフィス",
+			expectedPlace: model.Place{PrefectureCode: "13", Prefecture: "東京都", CityCode: "13103", City: "港区", Region: model.RegionKanto},
+			expectedOK:    true,
+		},
+		{name: "City is preferred over a ward of another prefecture", text: "大阪府大阪市北区梅田", expectedPlace: osakaCity, expectedOK: true},
+		{name: "City without its prefecture", text: "大阪市", expectedPlace: osakaCity, expectedOK: true},
+		{
+			name:          "City name without 市",
+			text:          "横浜",
+			expectedPlace: model.Place{PrefectureCode: "14", Prefecture: "神奈川県", CityCode: "14100", City: "横浜市", Region: model.RegionKanto},
+			expectedOK:    true,
+		},
+		{
+			name:          "Romaji is matched as a whole word",
+			text:          "Kitakyushu, Fukuoka",
+			expectedPlace: model.Place{PrefectureCode: "40", Prefecture: "福岡県", CityCode: "40100", City: "北九州市", Region: model.RegionKyushu},
+			expectedOK:    true,
+		},
+		{
+			name:          "Prefecture of Hokkaido",
+			text:          "Sapporo, Hokkaido",
+			expectedPlace: model.Place{PrefectureCode: "01", Prefecture: "北海道", CityCode: "01100", City: "札幌市", Region: model.RegionHokkaido},
+			expectedOK:    true,
+		},
+		{
+			name:          "Okinawa belongs to the Kyushu region",
+			text:          "沖縄県那覇市",
+			expectedPlace: model.Place{PrefectureCode: "47", Prefecture: "沖縄県", CityCode: "47201", City: "那覇市", Region: model.RegionKyushu},
+			expectedOK:    true,
+		},
+		{name: "Remote only", text: "フルリモート", expectedPlace: model.Place{Remote: true}, expectedOK: true},
+		{name: "Remote in English", text: "Remote (Japan)", expectedPlace: model.Place{Remote: true}, expectedOK: true},
+		{
+			name:          "Place and remote",
+			text:          "東京都渋谷区 (リモート可)",
+			expectedPlace: model.Place{PrefectureCode: "13", Prefecture: "東京都", CityCode: "13113", City: "渋谷区", Region: model.RegionKanto, Remote: true},
+			expectedOK:    true,
+		},
+		{
+			name:          "Place with remote not allowed",
+			text:          "東京都 (リモート不可)",
+			expectedPlace: model.Place{PrefectureCode: "13", Prefecture: "東京都", Region: model.RegionKanto},
+			expectedOK:    true,
+		},
+		{
+			name:          "Place with remote work impossible",
+			text:          "大阪府 在宅勤務は不可能",
+			expectedPlace: model.Place{PrefectureCode: "27", Prefecture: "大阪府", Region: model.RegionKinki},
+			expectedOK:    true,
+		},
+		{
+			name:          "Place with no remote in English",
+			text:          "No remote; onsite in Osaka",
+			expectedPlace: model.Place{PrefectureCode: "27", Prefecture: "大阪府", Region: model.RegionKinki},
+			expectedOK:    true,
+		},
+		{name: "Remote not available only", text: "Remote not available", expectedOK: false},
+		{name: "Unknown place", text: "Japan", expectedOK: false},
+		{name: "Empty text", text: "", expectedOK: false},
+	}
+
+	n, err := NewNormalizer()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			place, ok := n.Resolve(tt.text)
+
+			// Assert
+			if ok != tt.expectedOK {
+				t.Fatalf("Expected ok=%v, got %v (%+v)", tt.expectedOK, ok, place)
+			}
+			if place != tt.expectedPlace {
+				t.Errorf("Place mismatch:\n  expected: %+v\n  got:      %+v", tt.expectedPlace, place)
+			}
+		})
+	}
+}
+
+func TestNormalizer_Normalize(t *testing.T) {
+	tests := []struct {
+		name        string
+		job         model.Job
+		expectedJob model.Job
+	}{
+		{
+			name: "Place is resolved from the location",
+			job:  model.Job{ID: "1", Location: "Tokyo, Japan"},
+			expectedJob: model.Job{
+				ID:       "1",
+				Location: "Tokyo, Japan",
+				Place:    &model.Place{PrefectureCode: "13", Prefecture: "東京都", Region: model.RegionKanto},
+			},
+		},
+		{
+			name:        "Place provided by the upstream is kept",
+			job:         model.Job{ID: "1", Location: "Tokyo", Place: &model.Place{PrefectureCode: "27"}},
+			expectedJob: model.Job{ID: "1", Location: "Tokyo", Place: &model.Place{PrefectureCode: "27"}},
+		},
+		{
+			name:        "Unknown location leaves the place empty",
+			job:         model.Job{ID: "1", Location: "Berlin"},
+			expectedJob: model.Job{ID: "1", Location: "Berlin"},
+		},
+	}
+
+	n, err := NewNormalizer()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			job := tt.job
+
+			// Act
+			n.Normalize(&job)
+
+			// Assert
+			if !reflect.DeepEqual(job, tt.expectedJob) {
+				t.Errorf("Job mismatch:\n  expected: %+v\n  got:      %+v", tt.expectedJob, job)
+			}
+		})
+	}
+}
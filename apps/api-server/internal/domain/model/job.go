@@ -1,10 +1,302 @@
 package model
 
-// Job represents a job posting
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+// EmploymentType is the contract type of a job posting
+type EmploymentType string
+
+const (
+	EmploymentFullTime   EmploymentType = "full_time"  // 正社員
+	EmploymentContract   EmploymentType = "contract"   // 契約社員
+	EmploymentFreelance  EmploymentType = "freelance"  // 業務委託
+	EmploymentPartTime   EmploymentType = "part_time"  // パート・アルバイト
+	EmploymentInternship EmploymentType = "internship" // インターン
+)
+
+// Valid reports whether t is a known employment type
+func (t EmploymentType) Valid() bool {
+	switch t {
+	case EmploymentFullTime, EmploymentContract, EmploymentFreelance, EmploymentPartTime, EmploymentInternship:
+		return true
+	}
+	return false
+}
+
+// RemotePolicy describes how much remote work a job allows
+type RemotePolicy string
+
+const (
+	RemoteOnsite RemotePolicy = "onsite"
+	RemoteHybrid RemotePolicy = "hybrid"
+	RemoteFull   RemotePolicy = "full_remote"
+)
+
+// Valid reports whether p is a known remote policy
+func (p RemotePolicy) Valid() bool {
+	return p == RemoteOnsite || p == RemoteHybrid || p == RemoteFull
+}
+
+// AllowsRemote reports whether the policy allows working remotely at least part of the time
+func (p RemotePolicy) AllowsRemote() bool {
+	return p == RemoteHybrid || p == RemoteFull
+}
+
+// SalaryPeriod is the period a posted salary amount refers to
+type SalaryPeriod string
+
+const (
+	SalaryYearly  SalaryPeriod = "year"  // 年収
+	SalaryMonthly SalaryPeriod = "month" // 月給
+	SalaryHourly  SalaryPeriod = "hour"  // 時給
+)
+
+// Valid reports whether p is a known salary period
+func (p SalaryPeriod) Valid() bool {
+	return p == SalaryYearly || p == SalaryMonthly || p == SalaryHourly
+}
+
+// Salary is the pay range as posted, before normalization to annual JPY
+type Salary struct {
+	Min      int64        `json:"min,omitempty"`
+	Max      int64        `json:"max,omitempty"`
+	Currency string       `json:"currency"` // ISO 4217, e.g. JPY
+	Period   SalaryPeriod `json:"period"`
+}
+
+// Region is a region of Japan grouping several prefectures
+type Region string
+
+const (
+	RegionHokkaido Region = "hokkaido" // 北海道
+	RegionTohoku   Region = "tohoku"   // 東北
+	RegionKanto    Region = "kanto"    // 関東
+	RegionChubu    Region = "chubu"    // 中部
+	RegionKinki    Region = "kinki"    // 近畿
+	RegionChugoku  Region = "chugoku"  // 中国
+	RegionShikoku  Region = "shikoku"  // 四国
+	RegionKyushu   Region = "kyushu"   // 九州・沖縄
+)
+
+// Place is the structured form of Job.Location
+type Place struct {
+	PrefectureCode string `json:"prefecture_code,omitempty"` // JIS X 0401, e.g. "13"
+	Prefecture     string `json:"prefecture,omitempty"`      // e.g. 東京都
+	CityCode       string `json:"city_code,omitempty"`       // 全国地方公共団体コード (5桁), e.g. "13113"
+	City           string `json:"city,omitempty"`            // e.g. 渋谷区
+	Region         Region `json:"region,omitempty"`
+	Remote         bool   `json:"remote,omitempty"` // Location mentions remote work
+}
+
+// ValidPrefectureCode reports whether code is a two-digit JIS X 0401 prefecture code between "01" and "47"
+func ValidPrefectureCode(code string) bool {
+	if len(code) != 2 || code[0] < '0' || code[0] > '4' || code[1] < '0' || code[1] > '9' {
+		return false
+	}
+	return code != "00" && code <= "47"
+}
+
+// JapaneseLevel is the Japanese proficiency a job requires
+type JapaneseLevel string
+
+const (
+	JapaneseNone     JapaneseLevel = "none"
+	JapaneseN5       JapaneseLevel = "jlpt_n5"
+	JapaneseN4       JapaneseLevel = "jlpt_n4"
+	JapaneseN3       JapaneseLevel = "jlpt_n3"
+	JapaneseN2       JapaneseLevel = "jlpt_n2"
+	JapaneseN1       JapaneseLevel = "jlpt_n1"
+	JapaneseBusiness JapaneseLevel = "business"
+	JapaneseNative   JapaneseLevel = "native"
+)
+
+// Valid reports whether l is a known Japanese level
+func (l JapaneseLevel) Valid() bool {
+	switch l {
+	case JapaneseNone, JapaneseN5, JapaneseN4, JapaneseN3, JapaneseN2, JapaneseN1, JapaneseBusiness, JapaneseNative:
+		return true
+	}
+	return false
+}
+
+// EnglishLevel is the English proficiency a job requires
+type EnglishLevel string
+
+const (
+	EnglishNone           EnglishLevel = "none"
+	EnglishBasic          EnglishLevel = "basic"
+	EnglishConversational EnglishLevel = "conversational"
+	EnglishBusiness       EnglishLevel = "business"
+	EnglishFluent         EnglishLevel = "fluent"
+)
+
+// Valid reports whether l is a known English level
+func (l EnglishLevel) Valid() bool {
+	switch l {
+	case EnglishNone, EnglishBasic, EnglishConversational, EnglishBusiness, EnglishFluent:
+		return true
+	}
+	return false
+}
+
+// Job represents a job posting.
+// Fields added after the first five are omitted when unknown, so existing clients see the same payload for legacy jobs.
 type Job struct {
-	ID          string `json:"id"`
-	Title       string `json:"title"`
-	Company     string `json:"company"`
-	Location    string `json:"location"`
-	Description string `json:"description"`
+	ID                string         `json:"id"`
+	Title             string         `json:"title"`
+	Company           string         `json:"company"`
+	Location          string         `json:"location"`
+	Description       string         `json:"description"`
+	Place             *Place         `json:"place,omitempty"`
+	EmploymentType    EmploymentType `json:"employment_type,omitempty"`
+	RemotePolicy      RemotePolicy   `json:"remote_policy,omitempty"`
+	Salary            *Salary        `json:"salary,omitempty"`
+	SalaryText        string         `json:"salary_text,omitempty"`       // 求人票に記載された給与の原文
+	SalaryMin         int64          `json:"salary_min,omitempty"`        // 年収 (JPY)
+	SalaryMax         int64          `json:"salary_max,omitempty"`        // 年収 (JPY)
+	SalaryConfidence  float64        `json:"salary_confidence,omitempty"` // salary_min/salary_max の推定精度 (0〜1)
+	JapaneseLevel     JapaneseLevel  `json:"japanese_level,omitempty"`
+	EnglishLevel      EnglishLevel   `json:"english_level,omitempty"`
+	VisaSponsorship   *bool          `json:"visa_sponsorship,omitempty"`
+	RelocationSupport *bool          `json:"relocation_support,omitempty"`
+	TechStack         []string       `json:"tech_stack,omitempty"`
+	PostedAt          time.Time      `json:"posted_at,omitzero"`
+	ExpiresAt         time.Time      `json:"expires_at,omitzero"`
+	ApplyURL          string         `json:"apply_url,omitempty"`
+	Source            string         `json:"source,omitempty"`
+	SourceURLs        []string       `json:"source_urls,omitempty"` // 重複排除でまとめた全ソースの求人URL
+	UpdatedAt         time.Time      `json:"updated_at,omitzero"`   // 最後に内容が変わった時刻 (リポジトリが設定)
+}
+
+// Clone returns a deep copy of the job, so that the copy can be modified without affecting j
+func (j Job) Clone() Job {
+	if j.Place != nil {
+		place := *j.Place
+		j.Place = &place
+	}
+	if j.Salary != nil {
+		salary := *j.Salary
+		j.Salary = &salary
+	}
+	if j.VisaSponsorship != nil {
+		v := *j.VisaSponsorship
+		j.VisaSponsorship = &v
+	}
+	if j.RelocationSupport != nil {
+		v := *j.RelocationSupport
+		j.RelocationSupport = &v
+	}
+	if j.TechStack != nil {
+		j.TechStack = append([]string(nil), j.TechStack...)
+	}
+	if j.SourceURLs != nil {
+		j.SourceURLs = append([]string(nil), j.SourceURLs...)
+	}
+	return j
+}
+
+// MinSalaryConfidence is the lowest salary_confidence at which a job takes part in min_salary filtering.
+// Jobs normalized before confidence was recorded have a zero confidence and are always considered.
+const MinSalaryConfidence = 0.5
+
+// SalaryUpperBound returns the highest annual JPY the job pays, falling back to salary_min for open-ended ranges such as "600万円以上"
+func (j Job) SalaryUpperBound() int64 {
+	if j.SalaryMax > 0 {
+		return j.SalaryMax
+	}
+	return j.SalaryMin
+}
+
+// AllowsRemote reports whether the job can be done remotely.
+// The remote policy wins when it is known; otherwise a location such as "フルリモート" counts.
+func (j Job) AllowsRemote() bool {
+	if j.RemotePolicy != "" {
+		return j.RemotePolicy.AllowsRemote()
+	}
+	return j.Place != nil && j.Place.Remote
+}
+
+// Validate checks the job and returns an InvalidArgument error listing every offending field
+func (j Job) Validate() error {
+	var violations []apperr.FieldViolation
+	invalid := func(field, reason string) {
+		violations = append(violations, apperr.FieldViolation{Field: field, Reason: reason})
+	}
+
+	if strings.TrimSpace(j.ID) == "" {
+		invalid("id", "must not be empty")
+	}
+	if strings.TrimSpace(j.Title) == "" {
+		invalid("title", "must not be empty")
+	}
+	if j.Place != nil && j.Place.PrefectureCode != "" && !ValidPrefectureCode(j.Place.PrefectureCode) {
+		invalid("place.prefecture_code", fmt.Sprintf("unknown value %q", j.Place.PrefectureCode))
+	}
+	if j.EmploymentType != "" && !j.EmploymentType.Valid() {
+		invalid("employment_type", fmt.Sprintf("unknown value %q", j.EmploymentType))
+	}
+	if j.RemotePolicy != "" && !j.RemotePolicy.Valid() {
+		invalid("remote_policy", fmt.Sprintf("unknown value %q", j.RemotePolicy))
+	}
+	if j.Salary != nil {
+		violations = append(violations, j.Salary.violations()...)
+	}
+	if j.SalaryMin < 0 {
+		invalid("salary_min", "must not be negative")
+	}
+	if j.SalaryMax < 0 {
+		invalid("salary_max", "must not be negative")
+	}
+	if j.SalaryMin > 0 && j.SalaryMax > 0 && j.SalaryMin > j.SalaryMax {
+		invalid("salary_max", "must not be less than salary_min")
+	}
+	if j.SalaryConfidence < 0 || j.SalaryConfidence > 1 {
+		invalid("salary_confidence", "must be between 0 and 1")
+	}
+	if j.JapaneseLevel != "" && !j.JapaneseLevel.Valid() {
+		invalid("japanese_level", fmt.Sprintf("unknown value %q", j.JapaneseLevel))
+	}
+	if j.EnglishLevel != "" && !j.EnglishLevel.Valid() {
+		invalid("english_level", fmt.Sprintf("unknown value %q", j.EnglishLevel))
+	}
+	if !j.PostedAt.IsZero() && !j.ExpiresAt.IsZero() && j.ExpiresAt.Before(j.PostedAt) {
+		invalid("expires_at", "must not be before posted_at")
+	}
+	if j.ApplyURL != "" {
+		u, err := url.Parse(j.ApplyURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			invalid("apply_url", "must be an absolute http(s) URL")
+		}
+	}
+
+	if len(violations) > 0 {
+		return apperr.Invalid(violations)
+	}
+	return nil
+}
+
+// violations validates the posted salary range
+func (s Salary) violations() []apperr.FieldViolation {
+	var violations []apperr.FieldViolation
+
+	if len(s.Currency) != 3 || strings.ToUpper(s.Currency) != s.Currency {
+		violations = append(violations, apperr.FieldViolation{Field: "salary.currency", Reason: "must be an ISO 4217 code such as JPY"})
+	}
+	if !s.Period.Valid() {
+		violations = append(violations, apperr.FieldViolation{Field: "salary.period", Reason: "must be one of year, month, hour"})
+	}
+	if s.Min < 0 || s.Max < 0 {
+		violations = append(violations, apperr.FieldViolation{Field: "salary.min", Reason: "must not be negative"})
+	}
+	if s.Min > 0 && s.Max > 0 && s.Min > s.Max {
+		violations = append(violations, apperr.FieldViolation{Field: "salary.max", Reason: "must not be less than salary.min"})
+	}
+
+	return violations
 }
@@ -0,0 +1,174 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+func TestJob_Validate(t *testing.T) {
+	sponsored := true
+	postedAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		job            Job
+		expectedFields []string
+	}{
+		{
+			name: "Valid: Legacy job with only the original fields",
+			job:  Job{ID: "1", Title: "Backend Engineer", Company: "Startup", Location: "Tokyo", Description: "Go"},
+		},
+		{
+			name: "Valid: Fully populated job",
+			job: Job{
+				ID:                "1",
+				Title:             "Backend Engineer",
+				EmploymentType:    EmploymentFullTime,
+				RemotePolicy:      RemoteHybrid,
+				Salary:            &Salary{Min: 6000000, Max: 9000000, Currency: "JPY", Period: SalaryYearly},
+				SalaryMin:         6000000,
+				SalaryMax:         9000000,
+				JapaneseLevel:     JapaneseN2,
+				EnglishLevel:      EnglishBusiness,
+				VisaSponsorship:   &sponsored,
+				RelocationSupport: &sponsored,
+				TechStack:         []string{"go", "aws"},
+				PostedAt:          postedAt,
+				ExpiresAt:         postedAt.AddDate(0, 1, 0),
+				ApplyURL:          "https://example.com/apply",
+				Source:            "greenhouse",
+			},
+		},
+		{
+			name:           "Invalid: Missing ID and title",
+			job:            Job{},
+			expectedFields: []string{"id", "title"},
+		},
+		{
+			name: "Invalid: Unknown enum values",
+			job: Job{
+				ID:             "1",
+				Title:          "Backend Engineer",
+				EmploymentType: "seasonal",
+				RemotePolicy:   "sometimes",
+				JapaneseLevel:  "fluent",
+				EnglishLevel:   "jlpt_n1",
+			},
+			expectedFields: []string{"employment_type", "remote_policy", "japanese_level", "english_level"},
+		},
+		{
+			name: "Invalid: Salary, dates and apply URL",
+			job: Job{
+				ID:        "1",
+				Title:     "Backend Engineer",
+				Salary:    &Salary{Min: 9000000, Max: 6000000, Currency: "yen", Period: "week"},
+				PostedAt:  postedAt,
+				ExpiresAt: postedAt.AddDate(0, 0, -1),
+				ApplyURL:  "mailto:jobs@example.com",
+			},
+			expectedFields: []string{"salary.currency", "salary.period", "salary.max", "expires_at", "apply_url"},
+		},
+		{
+			name: "Invalid: Unknown prefecture code and salary confidence",
+			job: Job{
+				ID:               "1",
+				Title:            "Backend Engineer",
+				Place:            &Place{PrefectureCode: "99"},
+				SalaryConfidence: 1.5,
+			},
+			expectedFields: []string{"place.prefecture_code", "salary_confidence"},
+		},
+		{
+			name:           "Invalid: Negative salary max only",
+			job:            Job{ID: "1", Title: "Backend Engineer", SalaryMin: 6000000, SalaryMax: -1},
+			expectedFields: []string{"salary_max"},
+		},
+		{
+			name:           "Invalid: Negative salary min and max",
+			job:            Job{ID: "1", Title: "Backend Engineer", SalaryMin: -1, SalaryMax: -1},
+			expectedFields: []string{"salary_min", "salary_max"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := tt.job.Validate()
+
+			// Assert
+			if len(tt.expectedFields) == 0 {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if apperr.KindOf(err) != apperr.InvalidArgument {
+				t.Fatalf("Expected InvalidArgument, got %v", err)
+			}
+			fields := apperr.FieldsOf(err)
+			if len(fields) != len(tt.expectedFields) {
+				t.Fatalf("Expected %d violations, got %+v", len(tt.expectedFields), fields)
+			}
+			for i, field := range tt.expectedFields {
+				if fields[i].Field != field {
+					t.Errorf("Violation[%d]: expected field '%s', got '%s'", i, field, fields[i].Field)
+				}
+			}
+		})
+	}
+}
+
+func TestJob_JSON(t *testing.T) {
+	t.Run("Legacy job serializes exactly as before", func(t *testing.T) {
+		// Arrange
+		job := Job{ID: "1", Title: "Senior Go Developer", Company: "Tech Company A", Location: "Tokyo, Japan", Description: "Go"}
+
+		// Act
+		data, err := json.Marshal(job)
+
+		// Assert: 追加フィールドは出力されない
+		if err != nil {
+			t.Fatalf("Failed to marshal: %v", err)
+		}
+		expected := `{"id":"1","title":"Senior Go Developer","company":"Tech Company A","location":"Tokyo, Japan","description":"Go"}`
+		if string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+	})
+
+	t.Run("Enriched job round-trips", func(t *testing.T) {
+		// Arrange
+		sponsored := false
+		job := Job{
+			ID:              "1",
+			Title:           "Backend Engineer",
+			EmploymentType:  EmploymentContract,
+			Salary:          &Salary{Min: 400000, Max: 600000, Currency: "JPY", Period: SalaryMonthly},
+			JapaneseLevel:   JapaneseBusiness,
+			VisaSponsorship: &sponsored,
+			TechStack:       []string{"go"},
+			PostedAt:        time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+			ApplyURL:        "https://example.com/apply",
+		}
+
+		// Act
+		data, err := json.Marshal(job)
+		if err != nil {
+			t.Fatalf("Failed to marshal: %v", err)
+		}
+		var decoded Job
+		err = json.Unmarshal(data, &decoded)
+
+		// Assert: falseのvisa_sponsorshipも保持される
+		if err != nil {
+			t.Fatalf("Failed to unmarshal: %v", err)
+		}
+		if !reflect.DeepEqual(decoded, job) {
+			t.Errorf("Round-trip mismatch:\n  expected: %+v\n  got:      %+v", job, decoded)
+		}
+	})
+}
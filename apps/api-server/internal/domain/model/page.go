@@ -0,0 +1,31 @@
+package model
+
+const (
+	// DefaultPageSize is the number of jobs returned when no limit is given
+	DefaultPageSize = 20
+	// MaxPageSize keeps responses well below the API Gateway payload limit
+	MaxPageSize = 100
+)
+
+// Cursor marks a position in a sorted job listing. It is serialized into an opaque, signed string.
+type Cursor struct {
+	// ID is the ID of the job at the page boundary
+	ID string `json:"id"`
+	// Backward is true when the cursor points to the page before ID instead of after it.
+	// A backward cursor with an empty ID points to the last page.
+	Backward bool `json:"backward,omitempty"`
+	// Sort and Order record the ordering the cursor was issued for
+	Sort  SortKey   `json:"sort,omitempty"`
+	Order SortOrder `json:"order,omitempty"`
+	// Value is the sort value of the boundary job
+	Value SortValue `json:"value,omitzero"`
+}
+
+// JobPage is a single page of a job listing
+type JobPage struct {
+	Jobs       []Job  `json:"jobs"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+	// Total is the number of jobs matching the query across all pages; only set when requested
+	Total *int `json:"total,omitempty"`
+}
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+const (
+	maxKeywordLength = 200
+	maxFilterLength  = 100
+)
+
+// JobQuery holds the filters applied to a job listing. The zero value matches every job.
+type JobQuery struct {
+	Keyword        string         // q: matched against title, company and description
+	Location       string         // location: substring of Job.Location
+	Prefecture     string         // prefecture: JIS X 0401 code of Job.Place, e.g. "13"
+	Company        string         // company: substring of Job.Company
+	Remote         *bool          // remote: nil means "don't care"
+	EmploymentType EmploymentType // employment_type
+	MinSalary      int64          // min_salary: annual JPY
+
+	Sort  SortKey   // sort
+	Order SortOrder // order: empty means the key's default order
+
+	Limit        int    // limit: page size, 0 means DefaultPageSize
+	Cursor       string // cursor: opaque cursor from a previous page
+	IncludeTotal bool   // include_total: compute JobPage.Total
+}
+
+// PageSize returns the effective page size of the query
+func (q JobQuery) PageSize() int {
+	if q.Limit == 0 {
+		return DefaultPageSize
+	}
+	return q.Limit
+}
+
+// Validate checks the query and returns an InvalidArgument error listing every offending field
+func (q JobQuery) Validate() error {
+	var violations []apperr.FieldViolation
+
+	if len(q.Keyword) > maxKeywordLength {
+		violations = append(violations, apperr.FieldViolation{Field: "q", Reason: "must be at most 200 bytes"})
+	}
+	if len(q.Location) > maxFilterLength {
+		violations = append(violations, apperr.FieldViolation{Field: "location", Reason: "must be at most 100 bytes"})
+	}
+	if q.Prefecture != "" && !ValidPrefectureCode(q.Prefecture) {
+		violations = append(violations, apperr.FieldViolation{Field: "prefecture", Reason: "must be a prefecture code between 1 and 47"})
+	}
+	if len(q.Company) > maxFilterLength {
+		violations = append(violations, apperr.FieldViolation{Field: "company", Reason: "must be at most 100 bytes"})
+	}
+	if q.EmploymentType != "" && !q.EmploymentType.Valid() {
+		violations = append(violations, apperr.FieldViolation{
+			Field:  "employment_type",
+			Reason: "must be one of full_time, contract, freelance, part_time, internship",
+		})
+	}
+	if q.MinSalary < 0 {
+		violations = append(violations, apperr.FieldViolation{Field: "min_salary", Reason: "must not be negative"})
+	}
+	if !q.Sort.Valid() {
+		violations = append(violations, apperr.FieldViolation{
+			Field:  "sort",
+			Reason: "must be one of posted_at, salary_max, company, relevance",
+		})
+	}
+	if q.Sort == SortRelevance && strings.TrimSpace(q.Keyword) == "" {
+		violations = append(violations, apperr.FieldViolation{Field: "sort", Reason: "relevance requires q"})
+	}
+	if q.Order != "" && !q.Order.Valid() {
+		violations = append(violations, apperr.FieldViolation{Field: "order", Reason: "must be asc or desc"})
+	}
+	if q.Limit < 0 || q.Limit > MaxPageSize {
+		violations = append(violations, apperr.FieldViolation{Field: "limit", Reason: "must be between 1 and 100"})
+	}
+
+	if len(violations) > 0 {
+		return apperr.Invalid(violations)
+	}
+	return nil
+}
+
+// Matches reports whether job satisfies every filter of the query. Paging fields are ignored.
+func (q JobQuery) Matches(job Job) bool {
+	if q.Keyword != "" {
+		haystack := strings.ToLower(job.Title + "\n" + job.Company + "\n" + job.Description)
+		for _, term := range strings.Fields(strings.ToLower(q.Keyword)) {
+			if !strings.Contains(haystack, term) {
+				return false
+			}
+		}
+	}
+	if q.Location != "" && !containsFold(job.Location, q.Location) {
+		return false
+	}
+	if q.Company != "" && !containsFold(job.Company, q.Company) {
+		return false
+	}
+	if q.Prefecture != "" && (job.Place == nil || job.Place.PrefectureCode != q.Prefecture) {
+		return false
+	}
+	if q.Remote != nil && job.AllowsRemote() != *q.Remote {
+		return false
+	}
+	if q.EmploymentType != "" && job.EmploymentType != q.EmploymentType {
+		return false
+	}
+	// Jobs without salary information, or whose salary was only guessed, never satisfy a salary filter
+	if q.MinSalary > 0 {
+		if job.SalaryConfidence > 0 && job.SalaryConfidence < MinSalaryConfidence {
+			return false
+		}
+		if job.SalaryUpperBound() < q.MinSalary {
+			return false
+		}
+	}
+	return true
+}
+
+// containsFold reports whether substr is within s, ignoring case
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
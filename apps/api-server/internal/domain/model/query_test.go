@@ -0,0 +1,138 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+func TestJobQuery_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          JobQuery
+		expectedFields []string
+	}{
+		{
+			name:  "Valid: Zero value",
+			query: JobQuery{},
+		},
+		{
+			name: "Valid: Every filter set",
+			query: JobQuery{
+				Keyword:        "go",
+				Location:       "Tokyo",
+				Prefecture:     "13",
+				Company:        "Mercari",
+				EmploymentType: EmploymentFullTime,
+				MinSalary:      8000000,
+			},
+		},
+		{
+			name:           "Invalid: Prefecture code out of range",
+			query:          JobQuery{Prefecture: "48"},
+			expectedFields: []string{"prefecture"},
+		},
+		{
+			name:           "Invalid: Unknown employment type",
+			query:          JobQuery{EmploymentType: "seasonal"},
+			expectedFields: []string{"employment_type"},
+		},
+		{
+			name: "Invalid: Every offending field is listed",
+			query: JobQuery{
+				Keyword:        strings.Repeat("a", 201),
+				Location:       strings.Repeat("a", 101),
+				Company:        strings.Repeat("a", 101),
+				EmploymentType: "seasonal",
+				MinSalary:      -1,
+			},
+			expectedFields: []string{"q", "location", "company", "employment_type", "min_salary"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := tt.query.Validate()
+
+			// Assert
+			if len(tt.expectedFields) == 0 {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if apperr.KindOf(err) != apperr.InvalidArgument {
+				t.Fatalf("Expected InvalidArgument, got %v", err)
+			}
+			fields := apperr.FieldsOf(err)
+			if len(fields) != len(tt.expectedFields) {
+				t.Fatalf("Expected %d violations, got %d: %+v", len(tt.expectedFields), len(fields), fields)
+			}
+			for i, field := range tt.expectedFields {
+				if fields[i].Field != field {
+					t.Errorf("Violation[%d]: expected field '%s', got '%s'", i, field, fields[i].Field)
+				}
+			}
+		})
+	}
+}
+
+func TestJobQuery_Matches(t *testing.T) {
+	remote := true
+	onsite := false
+
+	job := Job{
+		ID:             "1",
+		Title:          "Senior Go Developer",
+		Company:        "Tech Company",
+		Location:       "Tokyo, Japan",
+		Description:    "Build APIs with Go and AWS",
+		EmploymentType: EmploymentFullTime,
+		RemotePolicy:   RemoteHybrid,
+		SalaryMin:      7000000,
+		SalaryMax:      10000000,
+	}
+
+	placed := job
+	placed.Place = &Place{PrefectureCode: "13", CityCode: "13113", Region: RegionKanto}
+
+	tests := []struct {
+		name     string
+		query    JobQuery
+		job      Job
+		expected bool
+	}{
+		{name: "Zero value matches everything", query: JobQuery{}, job: job, expected: true},
+		{name: "Keyword matches case-insensitively", query: JobQuery{Keyword: "go aws"}, job: job, expected: true},
+		{name: "Every keyword term must match", query: JobQuery{Keyword: "go rust"}, job: job, expected: false},
+		{name: "Location substring matches", query: JobQuery{Location: "tokyo"}, job: job, expected: true},
+		{name: "Location mismatch", query: JobQuery{Location: "Osaka"}, job: job, expected: false},
+		{name: "Company substring matches", query: JobQuery{Company: "tech"}, job: job, expected: true},
+		{name: "Remote=true matches hybrid", query: JobQuery{Remote: &remote}, job: job, expected: true},
+		{name: "Remote=false excludes hybrid", query: JobQuery{Remote: &onsite}, job: job, expected: false},
+		{name: "Employment type matches", query: JobQuery{EmploymentType: EmploymentFullTime}, job: job, expected: true},
+		{name: "Employment type mismatch", query: JobQuery{EmploymentType: EmploymentContract}, job: job, expected: false},
+		{name: "Prefecture matches place", query: JobQuery{Prefecture: "13"}, job: placed, expected: true},
+		{name: "Prefecture mismatch", query: JobQuery{Prefecture: "27"}, job: placed, expected: false},
+		{name: "Prefecture excludes jobs without place", query: JobQuery{Prefecture: "13"}, job: job, expected: false},
+		{name: "Remote=true matches remote location without policy", query: JobQuery{Remote: &remote}, job: Job{ID: "2", Place: &Place{Remote: true}}, expected: true},
+		{name: "Remote policy wins over location", query: JobQuery{Remote: &remote}, job: Job{ID: "2", RemotePolicy: RemoteOnsite, Place: &Place{Remote: true}}, expected: false},
+		{name: "Min salary within range", query: JobQuery{MinSalary: 9000000}, job: job, expected: true},
+		{name: "Min salary above range", query: JobQuery{MinSalary: 12000000}, job: job, expected: false},
+		{name: "Min salary excludes jobs without salary", query: JobQuery{MinSalary: 1}, job: Job{ID: "2"}, expected: false},
+		{name: "Min salary matches open-ended range by its lower bound", query: JobQuery{MinSalary: 6000000}, job: Job{ID: "2", SalaryMin: 6000000}, expected: true},
+		{name: "Min salary excludes low-confidence salary", query: JobQuery{MinSalary: 1}, job: Job{ID: "2", SalaryMax: 9000000, SalaryConfidence: 0.4}, expected: false},
+		{name: "Min salary keeps confident salary", query: JobQuery{MinSalary: 1}, job: Job{ID: "2", SalaryMax: 9000000, SalaryConfidence: 0.9}, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act & Assert
+			if got := tt.query.Matches(tt.job); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package model
+
+import (
+	"strings"
+)
+
+// SortKey selects the field a job listing is ordered by
+type SortKey string
+
+const (
+	// SortDefault orders jobs by ID only
+	SortDefault   SortKey = ""
+	SortPostedAt  SortKey = "posted_at"
+	SortSalaryMax SortKey = "salary_max"
+	SortCompany   SortKey = "company"
+	// SortRelevance orders jobs by how well they match JobQuery.Keyword
+	SortRelevance SortKey = "relevance"
+)
+
+// Valid reports whether k is a known sort key
+func (k SortKey) Valid() bool {
+	switch k {
+	case SortDefault, SortPostedAt, SortSalaryMax, SortCompany, SortRelevance:
+		return true
+	}
+	return false
+}
+
+// SortOrder is the direction of a sort
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// Valid reports whether o is a known sort order
+func (o SortOrder) Valid() bool {
+	return o == SortAsc || o == SortDesc
+}
+
+// SortValue is the value a job is ordered by. Only the field matching the sort key is set.
+type SortValue struct {
+	Int   int64   `json:"i,omitempty"`
+	Float float64 `json:"f,omitempty"`
+	Str   string  `json:"s,omitempty"`
+}
+
+// Compare returns -1, 0 or +1 depending on whether v sorts before, with or after other
+func (v SortValue) Compare(other SortValue) int {
+	switch {
+	case v.Int != other.Int:
+		return compareOrdered(v.Int, other.Int)
+	case v.Float != other.Float:
+		return compareOrdered(v.Float, other.Float)
+	default:
+		return strings.Compare(v.Str, other.Str)
+	}
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// EffectiveOrder returns the requested order, defaulting to descending for numeric and date keys
+func (q JobQuery) EffectiveOrder() SortOrder {
+	if q.Order != "" {
+		return q.Order
+	}
+	switch q.Sort {
+	case SortPostedAt, SortSalaryMax, SortRelevance:
+		return SortDesc
+	default:
+		return SortAsc
+	}
+}
+
+// SortValueOf returns the value job is ordered by under the query's sort key
+func (q JobQuery) SortValueOf(job Job) SortValue {
+	switch q.Sort {
+	case SortPostedAt:
+		if job.PostedAt.IsZero() {
+			return SortValue{}
+		}
+		return SortValue{Int: job.PostedAt.UnixNano()}
+	case SortSalaryMax:
+		return SortValue{Int: job.SalaryUpperBound()}
+	case SortCompany:
+		return SortValue{Str: strings.ToLower(job.Company)}
+	case SortRelevance:
+		return SortValue{Float: q.Relevance(job)}
+	default:
+		return SortValue{}
+	}
+}
+
+// Relevance scores how well job matches the query keyword. Title hits weigh more than company
+// hits, which weigh more than description hits.
+func (q JobQuery) Relevance(job Job) float64 {
+	title := strings.ToLower(job.Title)
+	company := strings.ToLower(job.Company)
+	description := strings.ToLower(job.Description)
+
+	var score float64
+	for _, term := range strings.Fields(strings.ToLower(q.Keyword)) {
+		score += 3 * float64(strings.Count(title, term))
+		score += 2 * float64(strings.Count(company, term))
+		score += float64(strings.Count(description, term))
+	}
+	return score
+}
@@ -0,0 +1,36 @@
+package repository
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+)
+
+// UpsertResult tells what an Upsert did to the stored job
+type UpsertResult string
+
+const (
+	Created   UpsertResult = "created"
+	Updated   UpsertResult = "updated"
+	Unchanged UpsertResult = "unchanged"
+)
+
+// JobRepository stores normalized jobs.
+// Implementations must be safe for concurrent use and return copies, so callers may modify the jobs they get.
+type JobRepository interface {
+	// Upsert stores job by ID and sets its UpdatedAt when the content changed
+	Upsert(ctx context.Context, job model.Job) (UpsertResult, error)
+	// Get returns the job with the given ID or an apperr.NotFound error
+	Get(ctx context.Context, id string) (*model.Job, error)
+	// Query returns every job matching query's filters, ordered by ID. Sorting and paging are left to the caller.
+	Query(ctx context.Context, query model.JobQuery) ([]model.Job, error)
+	// Delete removes the job with the given ID or returns an apperr.NotFound error
+	Delete(ctx context.Context, id string) error
+	// ListUpdatedSince returns the jobs whose UpdatedAt is after since, oldest first
+	ListUpdatedSince(ctx context.Context, since time.Time) ([]model.Job, error)
+	// Ping checks that the store can serve requests
+	Ping(ctx context.Context) error
+}
@@ -0,0 +1,382 @@
+package salary
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+)
+
+var (
+	// ErrUnparseable is returned when no salary amount can be found in the text
+	ErrUnparseable = errors.New("salary text could not be parsed")
+	// ErrUnsupportedCurrency is returned for salaries in a currency other than JPY and USD, which cannot be converted
+	ErrUnsupportedCurrency = errors.New("salary currency is not supported")
+)
+
+// Config holds the multipliers used to annualize salaries
+type Config struct {
+	BonusMonths  float64 // 月給を年収に換算する際の賞与月数 (月給 × (12 + BonusMonths))
+	HoursPerYear float64 // 時給を年収に換算する際の年間労働時間
+	USDJPYRate   float64 // USD → JPY の換算レート
+}
+
+// Result is the outcome of parsing a free-text salary
+type Result struct {
+	// Posted is the range as written, in its original currency and period
+	Posted model.Salary
+	// AnnualMin and AnnualMax are the range normalized to annual JPY; zero means unbounded
+	AnnualMin int64
+	AnnualMax int64
+	// Confidence is between 0 and 1; lower when the period or currency had to be guessed
+	Confidence float64
+}
+
+// Parser turns Japanese and English salary strings into annual JPY ranges
+type Parser struct {
+	cfg Config
+}
+
+// NewParser creates a new Parser
+func NewParser(cfg Config) *Parser {
+	return &Parser{cfg: cfg}
+}
+
+var (
+	// amountPattern matches a number with an optional Japanese or SI unit, e.g. "600万", "1.5億", "120k"
+	amountPattern = regexp.MustCompile(`(\d+(?:\.\d+)?)\s*(億|万|千|k|m)?`)
+	// bonusPattern extracts the bonus months written in the text, e.g. "賞与4ヶ月"
+	bonusPattern = regexp.MustCompile(`(?:賞与|ボーナス|bonus)[^\d]{0,4}(\d+(?:\.\d+)?)\s*(?:ヶ月|か月|ヵ月|カ月|months?)`)
+	// parenPattern matches parenthesized remarks such as "(固定残業代45時間分を含む)"
+	parenPattern = regexp.MustCompile(`\([^)]*\)`)
+	// rangeSeparators are normalized to "~"
+	rangeSeparators = strings.NewReplacer("〜", "~", "～", "~", "－", "~", "—", "~", "―", "~", "–", "~", "-", "~", "から", "~", " to ", "~")
+)
+
+var (
+	yearlyKeywords  = []string{"年収", "年俸", "年額", "annual", "per year", "a year", "/year", "/yr", "yearly", "p.a."}
+	monthlyKeywords = []string{"月給", "月収", "月額", "月俸", "monthly", "per month", "a month", "/month", "/mo"}
+	hourlyKeywords  = []string{"時給", "hourly", "per hour", "an hour", "/hour", "/hr", "/h"}
+	usdKeywords     = []string{"$", "usd", "ドル"}
+	jpyKeywords     = []string{"円", "¥", "jpy", "万", "億"}
+	// otherCurrencyPattern matches currencies that cannot be converted; it is checked first, since "s$" contains "$"
+	otherCurrencyPattern = regexp.MustCompile(`[€£₩₹元]|\b(?:s|hk|a|c|nz)\$|\b(?:eur|gbp|cny|rmb|krw|inr|sgd|hkd|aud|cad|nzd|chf)\b`)
+	// currencyBefore and currencyAfter are the currency markers that tie an adjacent number to an amount of money
+	currencyBefore  = []string{"$", "¥", "usd", "jpy"}
+	currencyAfter   = []string{"円", "ドル", "usd", "jpy"}
+	minOnlyKeywords = []string{"以上", "or more", "from"}
+	maxOnlyKeywords = []string{"以下", "まで", "up to", "max"}
+)
+
+// Parse extracts the posted range from text and normalizes it to annual JPY
+func (p *Parser) Parse(text string) (Result, error) {
+	normalized := normalizeText(text)
+
+	bonusMonths := p.cfg.BonusMonths
+	if m := bonusPattern.FindStringSubmatch(normalized); m != nil {
+		if v, err := strconv.ParseFloat(m[1], 64); err == nil {
+			bonusMonths = v
+		}
+	}
+	// Remarks in parentheses (bonus, overtime hours, ...) must not be mistaken for amounts
+	body := parenPattern.ReplaceAllString(normalized, " ")
+	body = bonusPattern.ReplaceAllString(body, " ")
+
+	if otherCurrencyPattern.MatchString(body) {
+		return Result{}, ErrUnsupportedCurrency
+	}
+
+	// Numbers such as dates and head counts are not amounts; see moneyAmounts
+	matches := moneyAmounts(body, amountPattern.FindAllStringSubmatchIndex(body, -1))
+	if len(matches) == 0 {
+		return Result{}, ErrUnparseable
+	}
+
+	confidence := 1.0
+
+	currency := detectCurrency(body)
+	if currency == "" {
+		currency = "JPY"
+		confidence -= 0.1
+	}
+	rate, _ := p.rate(currency)
+
+	first := parseAmount(body, matches[0])
+	var low, high float64
+	switch {
+	case len(matches) >= 2 && isRangeBetween(body[first.end:matches[1][0]]):
+		second := parseAmount(body, matches[1])
+		// "600~900万円" writes the unit only once
+		if first.unit == 1 && second.unit > 1 {
+			first.unit = second.unit
+		}
+		low, high = first.value*first.unit, second.value*second.unit
+	case strings.Contains(body[:matches[0][0]], "~") || containsAny(body, maxOnlyKeywords):
+		high = first.value * first.unit
+	case isOpenEnded(body[first.end:]) || containsAny(body, minOnlyKeywords):
+		low = first.value * first.unit
+	default:
+		// A single amount is both the lower and upper bound
+		low, high = first.value*first.unit, first.value*first.unit
+		confidence -= 0.1
+	}
+	if low > 0 && high > 0 && low > high {
+		low, high = high, low
+	}
+
+	period := detectPeriod(body)
+	if period == "" {
+		period = inferPeriod(math.Max(low, high) * rate)
+		confidence -= 0.3
+	}
+
+	multiplier := rate
+	switch period {
+	case model.SalaryMonthly:
+		multiplier *= 12 + bonusMonths
+	case model.SalaryHourly:
+		multiplier *= p.cfg.HoursPerYear
+	}
+
+	return Result{
+		Posted: model.Salary{
+			Min:      int64(math.Round(low)),
+			Max:      int64(math.Round(high)),
+			Currency: currency,
+			Period:   period,
+		},
+		AnnualMin:  int64(math.Round(low * multiplier)),
+		AnnualMax:  int64(math.Round(high * multiplier)),
+		Confidence: math.Round(confidence*100) / 100,
+	}, nil
+}
+
+// Annualize converts a structured posted salary to annual JPY. Currencies other than JPY and USD return
+// ErrUnsupportedCurrency.
+func (p *Parser) Annualize(s model.Salary) (annualMin, annualMax int64, err error) {
+	multiplier, ok := p.rate(s.Currency)
+	if !ok {
+		return 0, 0, ErrUnsupportedCurrency
+	}
+	switch s.Period {
+	case model.SalaryMonthly:
+		multiplier *= 12 + p.cfg.BonusMonths
+	case model.SalaryHourly:
+		multiplier *= p.cfg.HoursPerYear
+	}
+	return int64(math.Round(float64(s.Min) * multiplier)), int64(math.Round(float64(s.Max) * multiplier)), nil
+}
+
+// rate returns the JPY value of one unit of currency, reporting whether the currency can be converted
+func (p *Parser) rate(currency string) (float64, bool) {
+	switch currency {
+	case "JPY":
+		return 1, true
+	case "USD":
+		return p.cfg.USDJPYRate, true
+	default:
+		return 0, false
+	}
+}
+
+// Normalize fills the annual JPY range and parse confidence of job from its salary text or structured salary.
+// Jobs whose annual range was already provided by the upstream are left untouched, and a structured salary in an
+// unsupported currency keeps a zero range and confidence.
+func (p *Parser) Normalize(job *model.Job) {
+	if job.SalaryMin > 0 || job.SalaryMax > 0 {
+		if job.SalaryConfidence == 0 {
+			job.SalaryConfidence = 1
+		}
+		return
+	}
+
+	switch {
+	case job.Salary != nil && job.Salary.Period.Valid():
+		annualMin, annualMax, err := p.Annualize(*job.Salary)
+		if err != nil {
+			return
+		}
+		job.SalaryMin, job.SalaryMax = annualMin, annualMax
+		job.SalaryConfidence = 1
+	case job.SalaryText != "":
+		result, err := p.Parse(job.SalaryText)
+		if err != nil {
+			return
+		}
+		posted := result.Posted
+		job.Salary = &posted
+		job.SalaryMin = result.AnnualMin
+		job.SalaryMax = result.AnnualMax
+		job.SalaryConfidence = result.Confidence
+	}
+}
+
+type amount struct {
+	value float64
+	unit  float64
+	end   int // offset in body just after the amount and its unit
+}
+
+// parseAmount reads the number and unit of a match returned by amountPattern
+func parseAmount(body string, match []int) amount {
+	value, _ := strconv.ParseFloat(body[match[2]:match[3]], 64)
+	a := amount{value: value, unit: 1, end: match[3]}
+	if match[4] < 0 {
+		return a
+	}
+	// "k" and "m" are only units when they are not the start of a word such as "month"
+	if next := match[5]; (body[match[4]] == 'k' || body[match[4]] == 'm') && next < len(body) && isLetter(body[next]) {
+		return a
+	}
+	switch body[match[4]:match[5]] {
+	case "億":
+		a.unit = 1e8
+	case "万":
+		a.unit = 1e4
+	case "千", "k":
+		a.unit = 1e3
+	case "m":
+		a.unit = 1e6
+	}
+	a.end = match[5]
+	return a
+}
+
+// moneyAmounts keeps the matches of amountPattern that are amounts of money: those with a unit, those tied to a currency
+// or period keyword right before or after them, as in "¥500,000", "3000円" or "500000 monthly", and bare numbers
+// joined by a range to such an amount, as in "6000000~9000000円". Other numbers, such as the year of "2024年4月入社"
+// or a head count, are dropped.
+func moneyAmounts(body string, matches [][]int) [][]int {
+	tied := make([]bool, len(matches))
+	ends := make([]int, len(matches))
+	for i, m := range matches {
+		a := parseAmount(body, m)
+		ends[i] = a.end
+		before := strings.TrimRight(body[:m[0]], " :")
+		after := strings.TrimLeft(body[a.end:], " ")
+		tied[i] = a.unit > 1 ||
+			hasSuffixAny(before, currencyBefore) || hasSuffixAny(before, periodKeywords()) ||
+			hasPrefixAny(after, currencyAfter) || hasPrefixAny(after, periodKeywords())
+	}
+	joined := func(i int) bool { return isRangeBetween(body[ends[i]:matches[i+1][0]]) }
+
+	var kept [][]int
+	for i, m := range matches {
+		if tied[i] || (i > 0 && tied[i-1] && joined(i-1)) || (i+1 < len(matches) && tied[i+1] && joined(i)) {
+			kept = append(kept, m)
+		}
+	}
+	return kept
+}
+
+// periodKeywords returns the keywords of every period
+func periodKeywords() []string {
+	return slices.Concat(yearlyKeywords, monthlyKeywords, hourlyKeywords)
+}
+
+// isRangeBetween reports whether the text between two amounts joins them into a range, as in "円 ~ " or "~$"
+func isRangeBetween(between string) bool {
+	if !strings.Contains(between, "~") {
+		return false
+	}
+	rest := strings.NewReplacer("~", "", "円", "", "$", "", "¥", "", "usd", "", "jpy", "", "us", "").Replace(between)
+	return strings.TrimSpace(rest) == ""
+}
+
+// isOpenEnded reports whether the text right after an amount leaves its upper bound open, as in "35万円~" or "$100k+"
+func isOpenEnded(after string) bool {
+	after = strings.TrimLeft(after, " 円$¥")
+	return strings.HasPrefix(after, "~") || strings.HasPrefix(after, "+")
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+// normalizeText converts full-width characters to ASCII, drops thousands separators and lowercases the text
+func normalizeText(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r >= '０' && r <= '９':
+			r = '0' + (r - '０')
+		case r >= 'Ａ' && r <= 'Ｚ':
+			r = 'A' + (r - 'Ａ')
+		case r >= 'ａ' && r <= 'ｚ':
+			r = 'a' + (r - 'ａ')
+		case r == '（':
+			r = '('
+		case r == '）':
+			r = ')'
+		case r == '．':
+			r = '.'
+		case r == '＄':
+			r = '$'
+		case r == '￥':
+			r = '¥'
+		case r == '　':
+			r = ' '
+		case r == ',' || r == '，' || r == '、':
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return rangeSeparators.Replace(strings.ToLower(b.String()))
+}
+
+func detectCurrency(body string) string {
+	switch {
+	case containsAny(body, usdKeywords):
+		return "USD"
+	case containsAny(body, jpyKeywords):
+		return "JPY"
+	default:
+		return ""
+	}
+}
+
+func detectPeriod(body string) model.SalaryPeriod {
+	switch {
+	case containsAny(body, hourlyKeywords):
+		return model.SalaryHourly
+	case containsAny(body, monthlyKeywords):
+		return model.SalaryMonthly
+	case containsAny(body, yearlyKeywords):
+		return model.SalaryYearly
+	default:
+		return ""
+	}
+}
+
+// inferPeriod guesses the period from the magnitude of a JPY amount
+func inferPeriod(jpy float64) model.SalaryPeriod {
+	switch {
+	case jpy >= 2_000_000:
+		return model.SalaryYearly
+	case jpy >= 50_000:
+		return model.SalaryMonthly
+	default:
+		return model.SalaryHourly
+	}
+}
+
+func hasPrefixAny(s string, keywords []string) bool {
+	return slices.ContainsFunc(keywords, func(k string) bool { return strings.HasPrefix(s, k) })
+}
+
+func hasSuffixAny(s string, keywords []string) bool {
+	return slices.ContainsFunc(keywords, func(k string) bool { return strings.HasSuffix(s, k) })
+}
+
+func containsAny(s string, keywords []string) bool {
+	for _, k := range keywords {
+		if strings.Contains(s, k) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,507 @@
+package salary
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+)
+
+var testConfig = Config{BonusMonths: 2, HoursPerYear: 1920, USDJPYRate: 150}
+
+func TestParser_Parse(t *testing.T) {
+	tests := []struct {
+		name               string
+		text               string
+		expectedPosted     model.Salary
+		expectedAnnualMin  int64
+		expectedAnnualMax  int64
+		expectedConfidence float64
+		expectedErr        error
+	}{
+		// 年収
+		{
+			name:               "Yearly: 万円 range with unit on both bounds",
+			text:               "年収600万円〜900万円",
+			expectedPosted:     model.Salary{Min: 6000000, Max: 9000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  6000000,
+			expectedAnnualMax:  9000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Yearly: 万円 range with unit written once",
+			text:               "年収600〜900万円",
+			expectedPosted:     model.Salary{Min: 6000000, Max: 9000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  6000000,
+			expectedAnnualMax:  9000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Yearly: Full yen amounts with thousands separators",
+			text:               "年収 6,000,000円 ~ 9,000,000円",
+			expectedPosted:     model.Salary{Min: 6000000, Max: 9000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  6000000,
+			expectedAnnualMax:  9000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Yearly: Full-width digits",
+			text:               "年収６００万〜９００万円",
+			expectedPosted:     model.Salary{Min: 6000000, Max: 9000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  6000000,
+			expectedAnnualMax:  9000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Yearly: Full-width separators and wave dash",
+			text:               "年収　１，２００万円～１，８００万円",
+			expectedPosted:     model.Salary{Min: 12000000, Max: 18000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  12000000,
+			expectedAnnualMax:  18000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Yearly: Hyphen as range separator",
+			text:               "年収500万-700万円",
+			expectedPosted:     model.Salary{Min: 5000000, Max: 7000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  5000000,
+			expectedAnnualMax:  7000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Yearly: から as range separator",
+			text:               "年収500万円から800万円",
+			expectedPosted:     model.Salary{Min: 5000000, Max: 8000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  5000000,
+			expectedAnnualMax:  8000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Yearly: Remarks in parentheses are ignored",
+			text:               "年収 1200万円～1800万円（経験・能力を考慮の上、決定します）",
+			expectedPosted:     model.Salary{Min: 12000000, Max: 18000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  12000000,
+			expectedAnnualMax:  18000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Yearly: 年俸 with a single amount",
+			text:               "年俸1000万円",
+			expectedPosted:     model.Salary{Min: 10000000, Max: 10000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  10000000,
+			expectedAnnualMax:  10000000,
+			expectedConfidence: 0.9,
+		},
+		{
+			name:               "Yearly: Decimal 万 amount",
+			text:               "年収650.5万円",
+			expectedPosted:     model.Salary{Min: 6505000, Max: 6505000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  6505000,
+			expectedAnnualMax:  6505000,
+			expectedConfidence: 0.9,
+		},
+		{
+			name:               "Yearly: 億 unit",
+			text:               "年収1億円",
+			expectedPosted:     model.Salary{Min: 100000000, Max: 100000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  100000000,
+			expectedAnnualMax:  100000000,
+			expectedConfidence: 0.9,
+		},
+		{
+			name:               "Yearly: Lower bound only with 以上",
+			text:               "年収600万円以上",
+			expectedPosted:     model.Salary{Min: 6000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  6000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Yearly: Lower bound only with trailing wave dash",
+			text:               "年収600万円〜",
+			expectedPosted:     model.Salary{Min: 6000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  6000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Yearly: Upper bound only with leading wave dash",
+			text:               "年収〜900万円",
+			expectedPosted:     model.Salary{Max: 9000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMax:  9000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Yearly: Upper bound only with まで",
+			text:               "年収900万円まで",
+			expectedPosted:     model.Salary{Max: 9000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMax:  9000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Yearly: Reversed bounds are swapped",
+			text:               "年収900万円〜600万円",
+			expectedPosted:     model.Salary{Min: 6000000, Max: 9000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  6000000,
+			expectedAnnualMax:  9000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Yearly: Yen sign amounts in English",
+			text:               "Annual salary: ¥8,000,000 - ¥12,000,000",
+			expectedPosted:     model.Salary{Min: 8000000, Max: 12000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  8000000,
+			expectedAnnualMax:  12000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Yearly: JPY with M unit",
+			text:               "JPY 7M - 10M per year",
+			expectedPosted:     model.Salary{Min: 7000000, Max: 10000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  7000000,
+			expectedAnnualMax:  10000000,
+			expectedConfidence: 1,
+		},
+		// 月給
+		{
+			name:               "Monthly: Single amount includes default bonus months",
+			text:               "月給40万円",
+			expectedPosted:     model.Salary{Min: 400000, Max: 400000, Currency: "JPY", Period: model.SalaryMonthly},
+			expectedAnnualMin:  5600000,
+			expectedAnnualMax:  5600000,
+			expectedConfidence: 0.9,
+		},
+		{
+			name:               "Monthly: Range",
+			text:               "月給30万円〜50万円",
+			expectedPosted:     model.Salary{Min: 300000, Max: 500000, Currency: "JPY", Period: model.SalaryMonthly},
+			expectedAnnualMin:  4200000,
+			expectedAnnualMax:  7000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Monthly: Bonus months written in the text override the default",
+			text:               "月給40万円（賞与年4ヶ月）",
+			expectedPosted:     model.Salary{Min: 400000, Max: 400000, Currency: "JPY", Period: model.SalaryMonthly},
+			expectedAnnualMin:  6400000,
+			expectedAnnualMax:  6400000,
+			expectedConfidence: 0.9,
+		},
+		{
+			name:               "Monthly: Bonus months outside parentheses",
+			text:               "月給 25万円〜35万円 賞与3.5ヶ月分",
+			expectedPosted:     model.Salary{Min: 250000, Max: 350000, Currency: "JPY", Period: model.SalaryMonthly},
+			expectedAnnualMin:  3875000,
+			expectedAnnualMax:  5425000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Monthly: Overtime remark after an open-ended amount is not an upper bound",
+			text:               "月給35万円〜 ※固定残業代45時間分を含む",
+			expectedPosted:     model.Salary{Min: 350000, Currency: "JPY", Period: model.SalaryMonthly},
+			expectedAnnualMin:  4900000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Monthly: Bonus count is not an amount",
+			text:               "月給40万円 賞与年2回",
+			expectedPosted:     model.Salary{Min: 400000, Max: 400000, Currency: "JPY", Period: model.SalaryMonthly},
+			expectedAnnualMin:  5600000,
+			expectedAnnualMax:  5600000,
+			expectedConfidence: 0.9,
+		},
+		{
+			name:               "Monthly: 月額 with full yen amount",
+			text:               "月額 450,000円",
+			expectedPosted:     model.Salary{Min: 450000, Max: 450000, Currency: "JPY", Period: model.SalaryMonthly},
+			expectedAnnualMin:  6300000,
+			expectedAnnualMax:  6300000,
+			expectedConfidence: 0.9,
+		},
+		{
+			name:               "Monthly: English with month keyword after the amount",
+			text:               "¥500,000 per month",
+			expectedPosted:     model.Salary{Min: 500000, Max: 500000, Currency: "JPY", Period: model.SalaryMonthly},
+			expectedAnnualMin:  7000000,
+			expectedAnnualMax:  7000000,
+			expectedConfidence: 0.9,
+		},
+		{
+			name:               "Monthly: m of monthly is not a million unit",
+			text:               "500000 monthly",
+			expectedPosted:     model.Salary{Min: 500000, Max: 500000, Currency: "JPY", Period: model.SalaryMonthly},
+			expectedAnnualMin:  7000000,
+			expectedAnnualMax:  7000000,
+			expectedConfidence: 0.8,
+		},
+		// 時給
+		{
+			name:               "Hourly: Single amount",
+			text:               "時給3,000円",
+			expectedPosted:     model.Salary{Min: 3000, Max: 3000, Currency: "JPY", Period: model.SalaryHourly},
+			expectedAnnualMin:  5760000,
+			expectedAnnualMax:  5760000,
+			expectedConfidence: 0.9,
+		},
+		{
+			name:               "Hourly: Range",
+			text:               "時給2000円〜3500円",
+			expectedPosted:     model.Salary{Min: 2000, Max: 3500, Currency: "JPY", Period: model.SalaryHourly},
+			expectedAnnualMin:  3840000,
+			expectedAnnualMax:  6720000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "Hourly: Remarks in half-width parentheses are ignored",
+			text:               "時給1500円 (交通費は別途2万円まで支給)",
+			expectedPosted:     model.Salary{Min: 1500, Max: 1500, Currency: "JPY", Period: model.SalaryHourly},
+			expectedAnnualMin:  2880000,
+			expectedAnnualMax:  2880000,
+			expectedConfidence: 0.9,
+		},
+		// USD
+		{
+			name:               "USD: k unit range per year",
+			text:               "$120k - $150k per year",
+			expectedPosted:     model.Salary{Min: 120000, Max: 150000, Currency: "USD", Period: model.SalaryYearly},
+			expectedAnnualMin:  18000000,
+			expectedAnnualMax:  22500000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "USD: Code prefix and annually",
+			text:               "USD 100,000 - 140,000 annually",
+			expectedPosted:     model.Salary{Min: 100000, Max: 140000, Currency: "USD", Period: model.SalaryYearly},
+			expectedAnnualMin:  15000000,
+			expectedAnnualMax:  21000000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "USD: Hourly",
+			text:               "$60/hour",
+			expectedPosted:     model.Salary{Min: 60, Max: 60, Currency: "USD", Period: model.SalaryHourly},
+			expectedAnnualMin:  17280000,
+			expectedAnnualMax:  17280000,
+			expectedConfidence: 0.9,
+		},
+		{
+			name:               "USD: Open-ended with plus",
+			text:               "$150k+ /yr",
+			expectedPosted:     model.Salary{Min: 150000, Currency: "USD", Period: model.SalaryYearly},
+			expectedAnnualMin:  22500000,
+			expectedConfidence: 1,
+		},
+		{
+			name:               "USD: Unit written once and period inferred",
+			text:               "$90-120k",
+			expectedPosted:     model.Salary{Min: 90000, Max: 120000, Currency: "USD", Period: model.SalaryYearly},
+			expectedAnnualMin:  13500000,
+			expectedAnnualMax:  18000000,
+			expectedConfidence: 0.7,
+		},
+		// 期間・通貨の推定
+		{
+			name:               "Inferred: Yearly period from magnitude",
+			text:               "600万〜900万",
+			expectedPosted:     model.Salary{Min: 6000000, Max: 9000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  6000000,
+			expectedAnnualMax:  9000000,
+			expectedConfidence: 0.7,
+		},
+		{
+			name:               "Inferred: Bare lower bound joined to an amount in yen",
+			text:               "6000000-9000000円",
+			expectedPosted:     model.Salary{Min: 6000000, Max: 9000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  6000000,
+			expectedAnnualMax:  9000000,
+			expectedConfidence: 0.7,
+		},
+		{
+			name:               "Inferred: Monthly period from magnitude",
+			text:               "40万円",
+			expectedPosted:     model.Salary{Min: 400000, Max: 400000, Currency: "JPY", Period: model.SalaryMonthly},
+			expectedAnnualMin:  5600000,
+			expectedAnnualMax:  5600000,
+			expectedConfidence: 0.6,
+		},
+		{
+			name:               "Inferred: Hourly period from magnitude",
+			text:               "2500円",
+			expectedPosted:     model.Salary{Min: 2500, Max: 2500, Currency: "JPY", Period: model.SalaryHourly},
+			expectedAnnualMin:  4800000,
+			expectedAnnualMax:  4800000,
+			expectedConfidence: 0.6,
+		},
+		// 金額ではない数値
+		{
+			name:               "Not an amount: Date before the salary",
+			text:               "2024年4月入社 年収600万円",
+			expectedPosted:     model.Salary{Min: 6000000, Max: 6000000, Currency: "JPY", Period: model.SalaryYearly},
+			expectedAnnualMin:  6000000,
+			expectedAnnualMax:  6000000,
+			expectedConfidence: 0.9,
+		},
+		{
+			name:               "Not an amount: Head count before the salary",
+			text:               "募集人数2名 月給40万円",
+			expectedPosted:     model.Salary{Min: 400000, Max: 400000, Currency: "JPY", Period: model.SalaryMonthly},
+			expectedAnnualMin:  5600000,
+			expectedAnnualMax:  5600000,
+			expectedConfidence: 0.9,
+		},
+		{
+			name:        "Not an amount: Bare number",
+			text:        "400000",
+			expectedErr: ErrUnparseable,
+		},
+		{
+			name:        "Not an amount: Bare range",
+			text:        "6000000-9000000",
+			expectedErr: ErrUnparseable,
+		},
+		{
+			name:        "Not an amount: Date only",
+			text:        "2024年4月入社",
+			expectedErr: ErrUnparseable,
+		},
+		// 換算できない通貨
+		{
+			name:        "Unsupported currency: Euro sign",
+			text:        "€60,000 per year",
+			expectedErr: ErrUnsupportedCurrency,
+		},
+		{
+			name:        "Unsupported currency: Currency code",
+			text:        "GBP 50,000 - 70,000 annually",
+			expectedErr: ErrUnsupportedCurrency,
+		},
+		{
+			name:        "Unsupported currency: Singapore dollar is not USD",
+			text:        "S$8,000 per month",
+			expectedErr: ErrUnsupportedCurrency,
+		},
+		// 解析不能
+		{
+			name:        "Error: Empty text",
+			text:        "",
+			expectedErr: ErrUnparseable,
+		},
+		{
+			name:        "Error: Negotiable",
+			text:        "応相談",
+			expectedErr: ErrUnparseable,
+		},
+		{
+			name:        "Error: Only a remark in parentheses",
+			text:        "（経験・スキルを考慮し決定）",
+			expectedErr: ErrUnparseable,
+		},
+		{
+			name:        "Error: Competitive",
+			text:        "Competitive",
+			expectedErr: ErrUnparseable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			parser := NewParser(testConfig)
+
+			// Act
+			result, err := parser.Parse(tt.text)
+
+			// Assert
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Fatalf("Expected error '%v', got '%v'", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if result.Posted != tt.expectedPosted {
+				t.Errorf("Posted mismatch:\n  expected: %+v\n  got:      %+v", tt.expectedPosted, result.Posted)
+			}
+			if result.AnnualMin != tt.expectedAnnualMin || result.AnnualMax != tt.expectedAnnualMax {
+				t.Errorf("Expected annual range %d-%d, got %d-%d", tt.expectedAnnualMin, tt.expectedAnnualMax, result.AnnualMin, result.AnnualMax)
+			}
+			if result.Confidence != tt.expectedConfidence {
+				t.Errorf("Expected confidence %v, got %v", tt.expectedConfidence, result.Confidence)
+			}
+		})
+	}
+}
+
+func TestParser_Normalize(t *testing.T) {
+	tests := []struct {
+		name        string
+		job         model.Job
+		expectedJob model.Job
+	}{
+		{
+			name: "Salary text is parsed into the annual range",
+			job:  model.Job{ID: "1", SalaryText: "月給40万円〜50万円"},
+			expectedJob: model.Job{
+				ID:               "1",
+				SalaryText:       "月給40万円〜50万円",
+				Salary:           &model.Salary{Min: 400000, Max: 500000, Currency: "JPY", Period: model.SalaryMonthly},
+				SalaryMin:        5600000,
+				SalaryMax:        7000000,
+				SalaryConfidence: 1,
+			},
+		},
+		{
+			name: "Structured salary is annualized",
+			job:  model.Job{ID: "1", Salary: &model.Salary{Min: 100000, Max: 120000, Currency: "USD", Period: model.SalaryYearly}},
+			expectedJob: model.Job{
+				ID:               "1",
+				Salary:           &model.Salary{Min: 100000, Max: 120000, Currency: "USD", Period: model.SalaryYearly},
+				SalaryMin:        15000000,
+				SalaryMax:        18000000,
+				SalaryConfidence: 1,
+			},
+		},
+		{
+			name:        "Structured salary in an unsupported currency is not annualized",
+			job:         model.Job{ID: "1", Salary: &model.Salary{Min: 60000, Max: 80000, Currency: "EUR", Period: model.SalaryYearly}},
+			expectedJob: model.Job{ID: "1", Salary: &model.Salary{Min: 60000, Max: 80000, Currency: "EUR", Period: model.SalaryYearly}},
+		},
+		{
+			name:        "Salary text in an unsupported currency leaves the job untouched",
+			job:         model.Job{ID: "1", SalaryText: "€60,000 per year"},
+			expectedJob: model.Job{ID: "1", SalaryText: "€60,000 per year"},
+		},
+		{
+			name:        "Annual range provided by the upstream is kept",
+			job:         model.Job{ID: "1", SalaryText: "月給40万円", SalaryMin: 7000000, SalaryMax: 9000000},
+			expectedJob: model.Job{ID: "1", SalaryText: "月給40万円", SalaryMin: 7000000, SalaryMax: 9000000, SalaryConfidence: 1},
+		},
+		{
+			name:        "Unparseable salary text leaves the job untouched",
+			job:         model.Job{ID: "1", SalaryText: "応相談"},
+			expectedJob: model.Job{ID: "1", SalaryText: "応相談"},
+		},
+		{
+			name:        "Job without salary is untouched",
+			job:         model.Job{ID: "1"},
+			expectedJob: model.Job{ID: "1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			parser := NewParser(testConfig)
+			job := tt.job
+
+			// Act
+			parser.Normalize(&job)
+
+			// Assert
+			if !reflect.DeepEqual(job, tt.expectedJob) {
+				t.Errorf("Job mismatch:\n  expected: %+v\n  got:      %+v", tt.expectedJob, job)
+			}
+		})
+	}
+}
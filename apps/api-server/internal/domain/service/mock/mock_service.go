@@ -13,7 +13,9 @@ import (
 	context "context"
 	reflect "reflect"
 
+	dedup "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/dedup"
 	model "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	httpclient "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,17 +43,61 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// Breakers mocks base method.
+func (m *MockService) Breakers(ctx context.Context) []httpclient.BreakerStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Breakers", ctx)
+	ret0, _ := ret[0].([]httpclient.BreakerStatus)
+	return ret0
+}
+
+// Breakers indicates an expected call of Breakers.
+func (mr *MockServiceMockRecorder) Breakers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Breakers", reflect.TypeOf((*MockService)(nil).Breakers), ctx)
+}
+
+// DedupReport mocks base method.
+func (m *MockService) DedupReport(ctx context.Context, jobID string) (*dedup.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedupReport", ctx, jobID)
+	ret0, _ := ret[0].(*dedup.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedupReport indicates an expected call of DedupReport.
+func (mr *MockServiceMockRecorder) DedupReport(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedupReport", reflect.TypeOf((*MockService)(nil).DedupReport), ctx, jobID)
+}
+
 // FetchJobs mocks base method.
-func (m *MockService) FetchJobs(ctx context.Context) ([]model.Job, error) {
+func (m *MockService) FetchJobs(ctx context.Context, query model.JobQuery) (*model.JobPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchJobs", ctx)
-	ret0, _ := ret[0].([]model.Job)
+	ret := m.ctrl.Call(m, "FetchJobs", ctx, query)
+	ret0, _ := ret[0].(*model.JobPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FetchJobs indicates an expected call of FetchJobs.
-func (mr *MockServiceMockRecorder) FetchJobs(ctx any) *gomock.Call {
+func (mr *MockServiceMockRecorder) FetchJobs(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobs", reflect.TypeOf((*MockService)(nil).FetchJobs), ctx, query)
+}
+
+// GetJob mocks base method.
+func (m *MockService) GetJob(ctx context.Context, id string) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, id)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockServiceMockRecorder) GetJob(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobs", reflect.TypeOf((*MockService)(nil).FetchJobs), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockService)(nil).GetJob), ctx, id)
 }
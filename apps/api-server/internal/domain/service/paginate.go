@@ -0,0 +1,87 @@
+package service
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+)
+
+// ordering is the total order of a job listing: the query's sort value, then the job ID.
+// Breaking ties on ID keeps cursors stable when new jobs are inserted.
+type ordering struct {
+	query model.JobQuery
+	desc  bool
+}
+
+func newOrdering(query model.JobQuery) ordering {
+	return ordering{query: query, desc: query.EffectiveOrder() == model.SortDesc}
+}
+
+// compare orders a job with the given sort value and ID against another
+func (o ordering) compare(aValue model.SortValue, aID string, bValue model.SortValue, bID string) int {
+	c := aValue.Compare(bValue)
+	if o.desc {
+		c = -c
+	}
+	if c != 0 {
+		return c
+	}
+	return strings.Compare(aID, bID)
+}
+
+// sort orders jobs in place
+func (o ordering) sort(jobs []model.Job) {
+	values := make(map[string]model.SortValue, len(jobs))
+	for _, job := range jobs {
+		values[job.ID] = o.query.SortValueOf(job)
+	}
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return o.compare(values[jobs[i].ID], jobs[i].ID, values[jobs[j].ID], jobs[j].ID) < 0
+	})
+}
+
+// cursorAt returns a cursor whose boundary is job
+func (o ordering) cursorAt(job model.Job, backward bool) model.Cursor {
+	return model.Cursor{
+		ID:       job.ID,
+		Backward: backward,
+		Sort:     o.query.Sort,
+		Order:    o.query.EffectiveOrder(),
+		Value:    o.query.SortValueOf(job),
+	}
+}
+
+// matches reports whether pos was issued for this ordering
+func (o ordering) matches(pos model.Cursor) bool {
+	return pos.Sort == o.query.Sort && pos.Order == o.query.EffectiveOrder()
+}
+
+// paginate returns the page of jobs sorted by o located by pos (nil for the first page),
+// along with whether pages exist before and after it
+func (o ordering) paginate(jobs []model.Job, pos *model.Cursor, limit int) (page []model.Job, hasPrev, hasNext bool) {
+	start, end := 0, min(limit, len(jobs))
+
+	// cmp orders jobs[i] against the boundary job of the cursor
+	cmp := func(i int) int {
+		return o.compare(o.query.SortValueOf(jobs[i]), jobs[i].ID, pos.Value, pos.ID)
+	}
+
+	switch {
+	case pos == nil:
+	case pos.Backward && pos.ID == "":
+		// A backward cursor without a boundary points to the last page
+		start = max(0, len(jobs)-limit)
+		end = len(jobs)
+	case pos.Backward:
+		// The previous page ends right before the boundary job
+		end = sort.Search(len(jobs), func(i int) bool { return cmp(i) >= 0 })
+		start = max(0, end-limit)
+	default:
+		// The next page starts right after the boundary job
+		start = sort.Search(len(jobs), func(i int) bool { return cmp(i) > 0 })
+		end = min(start+limit, len(jobs))
+	}
+
+	return jobs[start:end], start > 0, end < len(jobs)
+}
@@ -4,39 +4,264 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/dedup"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/repository"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/cursor"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 )
 
 // Service is the interface for business logic
 type Service interface {
-	FetchJobs(ctx context.Context) ([]model.Job, error)
+	FetchJobs(ctx context.Context, query model.JobQuery) (*model.JobPage, error)
+	GetJob(ctx context.Context, id string) (*model.Job, error)
+	DedupReport(ctx context.Context, jobID string) (*dedup.Report, error)
+	Breakers(ctx context.Context) []httpclient.BreakerStatus
 }
 
-// ServiceImpl implements the Service interface
+// firstRefreshWait bounds how long a request waits for the first refresh of the repository, well within the 29 seconds
+// that API Gateway waits for a response
+const firstRefreshWait = 10 * time.Second
+
+// ServiceImpl implements the Service interface.
+// Jobs are served from the repository, which the pipeline refreshes in the background at most once per refreshInterval.
 type ServiceImpl struct {
-	httpClient httpclient.HttpClient
+	repo             repository.JobRepository
+	pipeline         ingest.Pipeline
+	cursors          *cursor.Codec
+	refreshInterval  time.Duration
+	firstRefreshWait time.Duration
+	now              func() time.Time
+
+	mu          sync.Mutex
+	refreshedAt time.Time
+	running     *refresh // refresh in flight, shared by every request that finds the repository stale
+
+	refreshes sync.WaitGroup // background refreshes in flight
+}
+
+// refresh is one run of the pipeline; err is set once done is closed
+type refresh struct {
+	done chan struct{}
+	err  error
 }
 
-// NewServiceImpl creates a new ServiceImpl
-func NewServiceImpl(httpClient httpclient.HttpClient) Service {
+// NewServiceImpl creates a new ServiceImpl.
+// A refreshInterval of zero or less disables refreshing, leaving the repository to the scheduled ingestion.
+func NewServiceImpl(repo repository.JobRepository, pipeline ingest.Pipeline, cursors *cursor.Codec, refreshInterval time.Duration) Service {
 	return &ServiceImpl{
-		httpClient: httpClient,
+		repo:             repo,
+		pipeline:         pipeline,
+		cursors:          cursors,
+		refreshInterval:  refreshInterval,
+		firstRefreshWait: firstRefreshWait,
+		now:              time.Now,
 	}
 }
 
-// FetchJobs fetches jobs using the HTTP client
-func (s *ServiceImpl) FetchJobs(ctx context.Context) ([]model.Job, error) {
-	logger.Info(ctx, "Fetching jobs from external API")
+// FetchJobs returns the page of stored jobs matching query, refreshing the repository first when it is stale
+func (s *ServiceImpl) FetchJobs(ctx context.Context, query model.JobQuery) (page *model.JobPage, err error) {
+	ctx, span := tracing.Start(ctx, "ServiceImpl.FetchJobs")
+	defer func() { tracing.End(span, err) }()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	order := newOrdering(query)
+
+	var pos *model.Cursor
+	if query.Cursor != "" {
+		pos = &model.Cursor{}
+		if err := s.cursors.Decode(query.Cursor, pos); err != nil {
+			return nil, apperr.Invalid([]apperr.FieldViolation{{Field: "cursor", Reason: "is invalid or has been tampered with"}})
+		}
+		if !order.matches(*pos) {
+			return nil, apperr.Invalid([]apperr.FieldViolation{{Field: "cursor", Reason: "was issued for a different sort"}})
+		}
+	}
+
+	if err := s.refreshIfStale(ctx); err != nil {
+		return nil, err
+	}
+
+	filtered, err := s.repo.Query(ctx, query)
+	if err != nil {
+		logger.Error(ctx, "Failed to query jobs from repository", zap.Error(err))
+		return nil, err
+	}
+	order.sort(filtered)
 
-	jobs, err := s.httpClient.GetJobs(ctx)
+	page, err = s.buildPage(filtered, order, pos, query)
 	if err != nil {
-		logger.Error(ctx, "Failed to fetch jobs from external API")
 		return nil, err
 	}
+	span.SetAttributes(attribute.Int("jobs.matched", len(filtered)), attribute.Int("jobs.returned", len(page.Jobs)))
+
+	logger.Info(ctx, "Successfully fetched jobs from repository", zap.Int("matched", len(filtered)), zap.Int("returned", len(page.Jobs)))
+	return page, nil
+}
+
+// refreshIfStale starts a refresh when the last one is older than refreshInterval, without waiting for it: the stored
+// jobs keep being served, and a failed refresh only logs a warning. Before the repository has been loaded once, the
+// request waits for the refresh for at most firstRefreshWait and returns its error.
+func (s *ServiceImpl) refreshIfStale(ctx context.Context) error {
+	if s.refreshInterval <= 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	loaded := !s.refreshedAt.IsZero()
+	if loaded && s.now().Sub(s.refreshedAt) < s.refreshInterval {
+		s.mu.Unlock()
+		return nil
+	}
+	r := s.startRefresh(ctx)
+	s.mu.Unlock()
+
+	if loaded {
+		return nil
+	}
+	timer := time.NewTimer(s.firstRefreshWait)
+	defer timer.Stop()
+	select {
+	case <-r.done:
+		return r.err
+	case <-timer.C:
+		logger.Warn(ctx, "Serving stored jobs while the first refresh is still running", zap.Duration("waited", s.firstRefreshWait))
+		return nil
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+// startRefresh runs the pipeline in the background unless a run is already in flight, and returns that run.
+// The caller must hold s.mu. The run outlives the request that started it.
+func (s *ServiceImpl) startRefresh(ctx context.Context) *refresh {
+	if s.running != nil {
+		return s.running
+	}
+	r := &refresh{done: make(chan struct{})}
+	s.running = r
+	s.refreshes.Add(1)
+	go func() {
+		defer s.refreshes.Done()
+		_, err := s.pipeline.Run(context.WithoutCancel(ctx))
+
+		s.mu.Lock()
+		if err == nil {
+			s.refreshedAt = s.now()
+		} else if !s.refreshedAt.IsZero() {
+			logger.Warn(ctx, "Serving stale jobs because the refresh failed", zap.Time("refreshed_at", s.refreshedAt), zap.Error(err))
+		}
+		s.running = nil
+		r.err = err
+		s.mu.Unlock()
+		close(r.done)
+	}()
+	return r
+}
+
+// buildPage cuts the page located by pos out of the sorted jobs and signs the cursors of its neighbours
+func (s *ServiceImpl) buildPage(jobs []model.Job, order ordering, pos *model.Cursor, query model.JobQuery) (*model.JobPage, error) {
+	items, hasPrev, hasNext := order.paginate(jobs, pos, query.PageSize())
+
+	page := &model.JobPage{Jobs: items}
+	if hasNext {
+		next, err := s.cursors.Encode(order.cursorAt(items[len(items)-1], false))
+		if err != nil {
+			return nil, apperr.Wrap(apperr.Internal, err, "failed to encode cursor")
+		}
+		page.NextCursor = next
+	}
+	if hasPrev {
+		// An empty page past the end links back to the last page
+		boundary := model.Cursor{Backward: true, Sort: query.Sort, Order: query.EffectiveOrder()}
+		if len(items) > 0 {
+			boundary = order.cursorAt(items[0], true)
+		}
+		prev, err := s.cursors.Encode(boundary)
+		if err != nil {
+			return nil, apperr.Wrap(apperr.Internal, err, "failed to encode cursor")
+		}
+		page.PrevCursor = prev
+	}
+	if query.IncludeTotal {
+		total := len(jobs)
+		page.Total = &total
+	}
+
+	return page, nil
+}
+
+// GetJob returns a single job by ID from the repository, falling back to the sources for jobs not stored yet
+func (s *ServiceImpl) GetJob(ctx context.Context, id string) (job *model.Job, err error) {
+	ctx, span := tracing.Start(ctx, "ServiceImpl.GetJob", trace.WithAttributes(attribute.String("job.id", id)))
+	defer func() { tracing.End(span, err) }()
+
+	if strings.TrimSpace(id) == "" {
+		return nil, apperr.New(apperr.InvalidArgument, "job id must not be empty")
+	}
+
+	job, err = s.repo.Get(ctx, id)
+	if err == nil {
+		return job, nil
+	}
+	if !apperr.Is(err, apperr.NotFound) {
+		logger.Error(ctx, "Failed to read job from repository", zap.String("job_id", id), zap.Error(err))
+		return nil, err
+	}
+
+	// Jobs posted since the last refresh are fetched directly and kept for the next requests
+	logger.Info(ctx, "Fetching job from sources", zap.String("job_id", id))
+
+	job, err = s.pipeline.FetchJob(ctx, id)
+	if err != nil {
+		if apperr.Is(err, apperr.NotFound) {
+			return nil, apperr.Wrap(apperr.NotFound, err, fmt.Sprintf("job %q not found", id))
+		}
+		logger.Error(ctx, "Failed to fetch job from sources", zap.String("error_code", string(apperr.KindOf(err))), zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info(ctx, "Successfully fetched job from sources", zap.String("job_id", id))
+	return job, nil
+}
+
+// DedupReport returns the duplicate clusters of the last ingestion run.
+// With a jobID, only the cluster containing that job is returned, whether it was kept or merged away.
+func (s *ServiceImpl) DedupReport(ctx context.Context, jobID string) (*dedup.Report, error) {
+	report := s.pipeline.DedupReport()
+	if report == nil {
+		return nil, apperr.New(apperr.NotFound, "no ingestion run has completed yet")
+	}
+	if jobID == "" {
+		return report, nil
+	}
+
+	cluster, ok := report.Cluster(jobID)
+	if !ok {
+		return nil, apperr.New(apperr.NotFound, fmt.Sprintf("job %q was not merged with another posting", jobID))
+	}
+	filtered := *report
+	filtered.Clusters = []dedup.Cluster{cluster}
+	logger.Debug(ctx, "Found duplicate cluster", zap.String("job_id", jobID), zap.String("canonical_id", cluster.CanonicalID))
+	return &filtered, nil
+}
 
-	logger.Info(ctx, "Successfully fetched jobs from external API")
-	return jobs, nil
+// Breakers returns the circuit breaker state of the sources
+func (s *ServiceImpl) Breakers(ctx context.Context) []httpclient.BreakerStatus {
+	return s.pipeline.Breakers()
 }
@@ -3,10 +3,26 @@ package service
 import (
 	"context"
 	"errors"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/dedup"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest"
+	mock_ingest "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest/mock"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/repository"
+	mock_repository "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/repository/mock"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
 	mock_httpclient "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient/mock"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/jobstore"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/cursor"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/tracing/tracingtest"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.uber.org/mock/gomock"
 )
 
@@ -16,6 +32,7 @@ func TestServiceImpl_FetchJobs(t *testing.T) {
 		mockSetup     func(*mock_httpclient.MockHttpClient)
 		expectedJobs  []model.Job
 		expectedError string
+		expectedKind  apperr.Kind
 		checkJobsNil  bool
 	}{
 		{
@@ -109,6 +126,16 @@ func TestServiceImpl_FetchJobs(t *testing.T) {
 			expectedError: "",
 			checkJobsNil:  false,
 		},
+		{
+			name: "Error: Typed upstream error kind is preserved",
+			mockSetup: func(m *mock_httpclient.MockHttpClient) {
+				m.EXPECT().GetJobs(gomock.Any()).Return(nil, apperr.New(apperr.UpstreamTimeout, "upstream request timed out"))
+			},
+			expectedJobs:  nil,
+			expectedError: "upstream request timed out",
+			expectedKind:  apperr.UpstreamTimeout,
+			checkJobsNil:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -120,11 +147,11 @@ func TestServiceImpl_FetchJobs(t *testing.T) {
 			mockClient := mock_httpclient.NewMockHttpClient(ctrl)
 			tt.mockSetup(mockClient)
 
-			svc := NewServiceImpl(mockClient)
+			svc := newTestService(mockClient, jobstore.NewMemory(), time.Hour)
 			ctx := context.Background()
 
 			// Act: テスト対象のメソッドを実行
-			jobs, err := svc.FetchJobs(ctx)
+			page, err := svc.FetchJobs(ctx, model.JobQuery{})
 
 			// Assert: エラーの検証
 			if tt.expectedError != "" {
@@ -134,6 +161,9 @@ func TestServiceImpl_FetchJobs(t *testing.T) {
 				if err.Error() != tt.expectedError {
 					t.Errorf("Expected error '%s', got '%s'", tt.expectedError, err.Error())
 				}
+				if tt.expectedKind != "" && apperr.KindOf(err) != tt.expectedKind {
+					t.Errorf("Expected error kind '%s', got '%s'", tt.expectedKind, apperr.KindOf(err))
+				}
 			} else {
 				if err != nil {
 					t.Fatalf("Expected no error, got %v", err)
@@ -142,15 +172,21 @@ func TestServiceImpl_FetchJobs(t *testing.T) {
 
 			// Assert: Jobsの検証
 			if tt.checkJobsNil {
-				if jobs != nil {
-					t.Errorf("Expected nil jobs, got %v", jobs)
+				if page != nil {
+					t.Errorf("Expected nil page, got %v", page)
 				}
 			} else {
+				jobs := page.Jobs
 				if len(jobs) != len(tt.expectedJobs) {
 					t.Fatalf("Expected %d jobs, got %d", len(tt.expectedJobs), len(jobs))
 				}
 				for i, expectedJob := range tt.expectedJobs {
-					if jobs[i] != expectedJob {
+					// UpdatedAt is set by the repository and Source by the pipeline
+					if jobs[i].UpdatedAt.IsZero() || jobs[i].Source != "test" {
+						t.Errorf("Job[%d]: expected UpdatedAt and Source to be set, got %+v", i, jobs[i])
+					}
+					jobs[i].UpdatedAt, jobs[i].Source = time.Time{}, ""
+					if !reflect.DeepEqual(jobs[i], expectedJob) {
 						t.Errorf("Job[%d] mismatch:\n  expected: %+v\n  got:      %+v", i, expectedJob, jobs[i])
 					}
 				}
@@ -158,3 +194,770 @@ func TestServiceImpl_FetchJobs(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceImpl_GetJob(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		mockSetup    func(*mock_httpclient.MockHttpClient)
+		expectedJob  *model.Job
+		expectedKind apperr.Kind
+	}{
+		{
+			name: "Success: Job is returned",
+			id:   "1",
+			mockSetup: func(m *mock_httpclient.MockHttpClient) {
+				m.EXPECT().GetJob(gomock.Any(), "1").Return(&model.Job{ID: "1", Title: "Test Job"}, nil)
+			},
+			expectedJob: &model.Job{ID: "1", Title: "Test Job"},
+		},
+		{
+			name: "Error: Unknown ID returns NotFound",
+			id:   "missing",
+			mockSetup: func(m *mock_httpclient.MockHttpClient) {
+				m.EXPECT().GetJob(gomock.Any(), "missing").Return(nil, apperr.New(apperr.NotFound, "upstream returned status 404"))
+			},
+			expectedKind: apperr.NotFound,
+		},
+		{
+			name: "Error: Upstream failure is passed through",
+			id:   "1",
+			mockSetup: func(m *mock_httpclient.MockHttpClient) {
+				m.EXPECT().GetJob(gomock.Any(), "1").Return(nil, apperr.New(apperr.UpstreamUnavailable, "upstream returned status 503"))
+			},
+			expectedKind: apperr.UpstreamUnavailable,
+		},
+		{
+			name:         "Error: Empty ID is rejected without calling upstream",
+			id:           " ",
+			mockSetup:    func(m *mock_httpclient.MockHttpClient) {},
+			expectedKind: apperr.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+			tt.mockSetup(mockClient)
+
+			svc := newTestService(mockClient, jobstore.NewMemory(), time.Hour)
+
+			// Act
+			job, err := svc.GetJob(context.Background(), tt.id)
+
+			// Assert
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				if job != nil {
+					t.Errorf("Expected nil job, got %+v", job)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			// Source is set by the pipeline
+			if job.Source != "test" {
+				t.Errorf("Expected source 'test', got '%s'", job.Source)
+			}
+			job.Source = ""
+			if !reflect.DeepEqual(*job, *tt.expectedJob) {
+				t.Errorf("Job mismatch:\n  expected: %+v\n  got:      %+v", *tt.expectedJob, *job)
+			}
+		})
+	}
+}
+
+func TestServiceImpl_FetchJobs_Query(t *testing.T) {
+	upstreamJobs := []model.Job{
+		{ID: "1", Title: "Senior Go Developer", Company: "Tech Company", Location: "Tokyo", EmploymentType: model.EmploymentFullTime, SalaryMax: 10000000},
+		{ID: "2", Title: "Backend Engineer", Company: "Startup", Location: "Osaka", EmploymentType: model.EmploymentContract, SalaryMax: 6000000},
+		{ID: "3", Title: "Go Engineer", Company: "Startup", Location: "Tokyo", EmploymentType: model.EmploymentFreelance},
+	}
+
+	tests := []struct {
+		name           string
+		query          model.JobQuery
+		expectUpstream bool
+		expectedIDs    []string
+		expectedKind   apperr.Kind
+	}{
+		{
+			name:           "Success: Keyword filter",
+			query:          model.JobQuery{Keyword: "go"},
+			expectUpstream: true,
+			expectedIDs:    []string{"1", "3"},
+		},
+		{
+			name:           "Success: Location and employment type filters are combined",
+			query:          model.JobQuery{Location: "tokyo", EmploymentType: model.EmploymentFreelance},
+			expectUpstream: true,
+			expectedIDs:    []string{"3"},
+		},
+		{
+			name:           "Success: Min salary filter",
+			query:          model.JobQuery{MinSalary: 8000000},
+			expectUpstream: true,
+			expectedIDs:    []string{"1"},
+		},
+		{
+			name:           "Success: No job matches",
+			query:          model.JobQuery{Company: "Unknown"},
+			expectUpstream: true,
+			expectedIDs:    []string{},
+		},
+		{
+			name:           "Error: Invalid query is rejected without calling upstream",
+			query:          model.JobQuery{EmploymentType: "seasonal"},
+			expectUpstream: false,
+			expectedKind:   apperr.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+			if tt.expectUpstream {
+				mockClient.EXPECT().GetJobs(gomock.Any()).Return(upstreamJobs, nil)
+			}
+
+			svc := newTestService(mockClient, jobstore.NewMemory(), time.Hour)
+
+			// Act
+			page, err := svc.FetchJobs(context.Background(), tt.query)
+
+			// Assert
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(page.Jobs) != len(tt.expectedIDs) {
+				t.Fatalf("Expected %d jobs, got %d", len(tt.expectedIDs), len(page.Jobs))
+			}
+			for i, id := range tt.expectedIDs {
+				if page.Jobs[i].ID != id {
+					t.Errorf("Job[%d]: expected ID '%s', got '%s'", i, id, page.Jobs[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceImpl_FetchJobs_Pagination(t *testing.T) {
+	t.Run("Success: Pages are walked forward and backward", func(t *testing.T) {
+		// Arrange: upstreamは順不同で5件を返す
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+		mockClient.EXPECT().GetJobs(gomock.Any()).Return(jobsWithIDs("e", "c", "a", "d", "b"), nil).AnyTimes()
+		svc := newTestService(mockClient, jobstore.NewMemory(), time.Hour)
+		ctx := context.Background()
+
+		// Act & Assert: 1ページ目
+		first, err := svc.FetchJobs(ctx, model.JobQuery{Limit: 2})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		assertIDs(t, first, "a", "b")
+		if first.PrevCursor != "" || first.NextCursor == "" {
+			t.Fatalf("Expected only next cursor on first page, got prev=%q next=%q", first.PrevCursor, first.NextCursor)
+		}
+
+		// Act & Assert: 2ページ目
+		second, err := svc.FetchJobs(ctx, model.JobQuery{Limit: 2, Cursor: first.NextCursor})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		assertIDs(t, second, "c", "d")
+
+		// Act & Assert: 最終ページ
+		last, err := svc.FetchJobs(ctx, model.JobQuery{Limit: 2, Cursor: second.NextCursor})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		assertIDs(t, last, "e")
+		if last.NextCursor != "" {
+			t.Errorf("Expected no next cursor on last page, got %q", last.NextCursor)
+		}
+
+		// Act & Assert: prev_cursorで戻る
+		back, err := svc.FetchJobs(ctx, model.JobQuery{Limit: 2, Cursor: last.PrevCursor})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		assertIDs(t, back, "c", "d")
+	})
+
+	t.Run("Success: Cursor stays stable when jobs are inserted", func(t *testing.T) {
+		// Arrange: 1ページ目取得後に先頭側へJobが追加される
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+		mockClient.EXPECT().GetJobs(gomock.Any()).Return(jobsWithIDs("b", "c", "d", "e"), nil)
+		repo := jobstore.NewMemory()
+		svc := newTestService(mockClient, repo, time.Hour)
+		ctx := context.Background()
+
+		// Act
+		first, err := svc.FetchJobs(ctx, model.JobQuery{Limit: 2})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		repo.Upsert(ctx, jobsWithIDs("a")[0])
+		second, err := svc.FetchJobs(ctx, model.JobQuery{Limit: 2, Cursor: first.NextCursor})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		// Assert: 挿入の影響を受けずに続きから取得できる
+		assertIDs(t, first, "b", "c")
+		assertIDs(t, second, "d", "e")
+	})
+
+	t.Run("Success: Total is only computed on request", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+		mockClient.EXPECT().GetJobs(gomock.Any()).Return(jobsWithIDs("a", "b", "c"), nil)
+		svc := newTestService(mockClient, jobstore.NewMemory(), time.Hour)
+		ctx := context.Background()
+
+		// Act
+		withoutTotal, _ := svc.FetchJobs(ctx, model.JobQuery{Limit: 1})
+		withTotal, _ := svc.FetchJobs(ctx, model.JobQuery{Limit: 1, IncludeTotal: true})
+
+		// Assert
+		if withoutTotal.Total != nil {
+			t.Errorf("Expected nil total, got %d", *withoutTotal.Total)
+		}
+		if withTotal.Total == nil || *withTotal.Total != 3 {
+			t.Errorf("Expected total 3, got %v", withTotal.Total)
+		}
+	})
+
+	t.Run("Error: Cursor signed with another secret is rejected", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		forged, _ := cursor.NewCodec("other-secret").Encode(model.Cursor{ID: "a"})
+		svc := newTestService(mock_httpclient.NewMockHttpClient(ctrl), jobstore.NewMemory(), time.Hour)
+
+		// Act
+		_, err := svc.FetchJobs(context.Background(), model.JobQuery{Cursor: forged})
+
+		// Assert
+		if apperr.KindOf(err) != apperr.InvalidArgument {
+			t.Fatalf("Expected InvalidArgument, got %v", err)
+		}
+		if fields := apperr.FieldsOf(err); len(fields) != 1 || fields[0].Field != "cursor" {
+			t.Errorf("Expected cursor violation, got %+v", fields)
+		}
+	})
+}
+
+func TestServiceImpl_FetchJobs_Sort(t *testing.T) {
+	base := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	upstreamJobs := []model.Job{
+		{ID: "1", Title: "Backend Engineer", Company: "beta", Description: "Go", SalaryMax: 8000000, PostedAt: base.Add(2 * time.Hour)},
+		{ID: "2", Title: "Go Engineer", Company: "Alpha", Description: "Go and Go", SalaryMax: 9000000, PostedAt: base},
+		{ID: "3", Title: "Go Developer", Company: "gamma", Description: "Kubernetes", SalaryMax: 8000000, PostedAt: base.Add(time.Hour)},
+		{ID: "4", Title: "Frontend Engineer", Company: "Alpha", Description: "TypeScript"},
+	}
+
+	tests := []struct {
+		name         string
+		query        model.JobQuery
+		expectedIDs  []string
+		expectedKind apperr.Kind
+	}{
+		{
+			name:        "Success: posted_at defaults to descending, undated jobs last",
+			query:       model.JobQuery{Sort: model.SortPostedAt},
+			expectedIDs: []string{"1", "3", "2", "4"},
+		},
+		{
+			name:        "Success: salary_max descending breaks ties on ID",
+			query:       model.JobQuery{Sort: model.SortSalaryMax, Order: model.SortDesc},
+			expectedIDs: []string{"2", "1", "3", "4"},
+		},
+		{
+			name:        "Success: salary_max ascending breaks ties on ID",
+			query:       model.JobQuery{Sort: model.SortSalaryMax, Order: model.SortAsc},
+			expectedIDs: []string{"4", "1", "3", "2"},
+		},
+		{
+			name:        "Success: company ascending is case-insensitive",
+			query:       model.JobQuery{Sort: model.SortCompany},
+			expectedIDs: []string{"2", "4", "1", "3"},
+		},
+		{
+			name:        "Success: relevance favours title hits",
+			query:       model.JobQuery{Keyword: "go", Sort: model.SortRelevance},
+			expectedIDs: []string{"2", "3", "1"},
+		},
+		{
+			name:         "Error: Unknown sort key",
+			query:        model.JobQuery{Sort: "popularity"},
+			expectedKind: apperr.InvalidArgument,
+		},
+		{
+			name:         "Error: Relevance without keyword",
+			query:        model.JobQuery{Sort: model.SortRelevance},
+			expectedKind: apperr.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+			if tt.expectedKind == "" {
+				mockClient.EXPECT().GetJobs(gomock.Any()).Return(append([]model.Job(nil), upstreamJobs...), nil)
+			}
+			svc := newTestService(mockClient, jobstore.NewMemory(), time.Hour)
+
+			// Act
+			page, err := svc.FetchJobs(context.Background(), tt.query)
+
+			// Assert
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(page.Jobs) != len(tt.expectedIDs) {
+				t.Fatalf("Expected %d jobs, got %d", len(tt.expectedIDs), len(page.Jobs))
+			}
+			for i, id := range tt.expectedIDs {
+				if page.Jobs[i].ID != id {
+					t.Errorf("Job[%d]: expected ID '%s', got '%s'", i, id, page.Jobs[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceImpl_FetchJobs_SortedPagination(t *testing.T) {
+	// Arrange: 同じ給与のJobが複数ある状態でページングする
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	upstreamJobs := []model.Job{
+		{ID: "a", Title: "Job a", SalaryMax: 5000000},
+		{ID: "b", Title: "Job b", SalaryMax: 9000000},
+		{ID: "c", Title: "Job c", SalaryMax: 7000000},
+		{ID: "d", Title: "Job d", SalaryMax: 7000000},
+		{ID: "e", Title: "Job e", SalaryMax: 7000000},
+	}
+	mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+	mockClient.EXPECT().GetJobs(gomock.Any()).Return(upstreamJobs, nil).AnyTimes()
+	svc := newTestService(mockClient, jobstore.NewMemory(), time.Hour)
+	ctx := context.Background()
+	query := model.JobQuery{Sort: model.SortSalaryMax, Limit: 2}
+
+	// Act: 全ページを辿る
+	var ids []string
+	for {
+		page, err := svc.FetchJobs(ctx, query)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		for _, job := range page.Jobs {
+			ids = append(ids, job.ID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		query.Cursor = page.NextCursor
+	}
+
+	// Assert: 重複や欠落なく決定的な順序で返る
+	expected := []string{"b", "c", "d", "e", "a"}
+	if strings.Join(ids, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected %v, got %v", expected, ids)
+	}
+
+	// Assert: 別のソート順にカーソルを流用するとエラーになる
+	_, err := svc.FetchJobs(ctx, model.JobQuery{Sort: model.SortCompany, Cursor: query.Cursor})
+	if apperr.KindOf(err) != apperr.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for mismatched cursor, got %v", err)
+	}
+}
+
+func TestServiceImpl_FetchJobs_SkipsInvalidJobs(t *testing.T) {
+	// Arrange: タイトルの無いJobと不正な雇用形態のJobが混ざっている
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+	mockClient.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{
+		{ID: "1", Title: "Backend Engineer"},
+		{ID: "2"},
+		{ID: "3", Title: "Frontend Engineer", EmploymentType: "seasonal"},
+	}, nil)
+	svc := newTestService(mockClient, jobstore.NewMemory(), time.Hour)
+
+	// Act
+	page, err := svc.FetchJobs(context.Background(), model.JobQuery{})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(page.Jobs) != 1 || page.Jobs[0].ID != "1" {
+		t.Errorf("Expected only job 1, got %+v", page.Jobs)
+	}
+}
+
+func TestServiceImpl_FetchJobs_Refresh(t *testing.T) {
+	t.Run("Success: Stale repository is served while it is refreshed in the background", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+		gomock.InOrder(
+			mockClient.EXPECT().GetJobs(gomock.Any()).Return(jobsWithIDs("a", "b"), nil),
+			mockClient.EXPECT().GetJobs(gomock.Any()).Return(jobsWithIDs("b", "c"), nil),
+		)
+		repo := jobstore.NewMemory()
+		svc := newTestService(mockClient, repo, time.Hour)
+		now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+		svc.(*ServiceImpl).now = func() time.Time { return now }
+		ctx := context.Background()
+
+		// Act: 初回はリフレッシュを待ち、2回目は待たずに保存済みの Job を返す
+		first, err := svc.FetchJobs(ctx, model.JobQuery{})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		now = now.Add(time.Hour)
+		second, err := svc.FetchJobs(ctx, model.JobQuery{})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		svc.(*ServiceImpl).refreshes.Wait()
+
+		// Assert: 削除された Job はバックグラウンドのリフレッシュで消える
+		assertIDs(t, first, "a", "b")
+		assertIDs(t, second, "a", "b")
+		stored, err := repo.Query(ctx, model.JobQuery{})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		assertIDs(t, &model.JobPage{Jobs: stored}, "b", "c")
+	})
+
+	t.Run("Success: Stored jobs are served when the first refresh is too slow", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		release := make(chan struct{})
+		mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+		mockClient.EXPECT().GetJobs(gomock.Any()).DoAndReturn(func(ctx context.Context) ([]model.Job, error) {
+			<-release
+			return jobsWithIDs("a"), nil
+		})
+		repo := jobstore.NewMemory()
+		repo.Upsert(context.Background(), jobsWithIDs("x")[0])
+		svc := newTestService(mockClient, repo, time.Hour)
+		svc.(*ServiceImpl).firstRefreshWait = time.Millisecond
+
+		// Act
+		page, err := svc.FetchJobs(context.Background(), model.JobQuery{})
+		close(release)
+		svc.(*ServiceImpl).refreshes.Wait()
+
+		// Assert
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		assertIDs(t, page, "x")
+	})
+
+	t.Run("Success: Stored jobs are served when the refresh fails", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+		gomock.InOrder(
+			mockClient.EXPECT().GetJobs(gomock.Any()).Return(jobsWithIDs("a", "b"), nil),
+			mockClient.EXPECT().GetJobs(gomock.Any()).Return(nil, apperr.New(apperr.UpstreamUnavailable, "upstream returned status 503")),
+		)
+		svc := newTestService(mockClient, jobstore.NewMemory(), time.Hour)
+		now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+		svc.(*ServiceImpl).now = func() time.Time { return now }
+		ctx := context.Background()
+
+		// Act: 2回目はリフレッシュ間隔が過ぎてから呼ぶ
+		svc.FetchJobs(ctx, model.JobQuery{})
+		now = now.Add(time.Hour)
+		page, err := svc.FetchJobs(ctx, model.JobQuery{})
+		svc.(*ServiceImpl).refreshes.Wait()
+
+		// Assert
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		assertIDs(t, page, "a", "b")
+	})
+
+	t.Run("Success: Refresh is disabled and the repository is served as is", func(t *testing.T) {
+		// Arrange: 上流は呼ばれない
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := jobstore.NewMemory()
+		repo.Upsert(context.Background(), jobsWithIDs("x")[0])
+		svc := newTestService(mock_httpclient.NewMockHttpClient(ctrl), repo, 0)
+
+		// Act
+		page, err := svc.FetchJobs(context.Background(), model.JobQuery{})
+
+		// Assert
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		assertIDs(t, page, "x")
+	})
+
+	t.Run("Error: Repository error is returned", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mock_repository.NewMockJobRepository(ctrl)
+		mockRepo.EXPECT().Query(gomock.Any(), gomock.Any()).Return(nil, apperr.New(apperr.Internal, "failed to query jobs"))
+		svc := newTestService(mock_httpclient.NewMockHttpClient(ctrl), mockRepo, 0)
+
+		// Act
+		page, err := svc.FetchJobs(context.Background(), model.JobQuery{})
+
+		// Assert
+		if apperr.KindOf(err) != apperr.Internal || page != nil {
+			t.Errorf("Expected Internal error and nil page, got %v, %+v", err, page)
+		}
+	})
+}
+
+func TestServiceImpl_FetchJobs_Span(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          model.JobQuery
+		expectedStatus codes.Code
+		expectReturned int64
+	}{
+		{
+			name:           "Success: Span records the page size",
+			expectedStatus: codes.Unset,
+			expectReturned: 2,
+		},
+		{
+			name:           "Error: Invalid query marks the span as failed",
+			query:          model.JobQuery{Cursor: "tampered"},
+			expectedStatus: codes.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange: 呼び出し元のスパンの子として記録される
+			exporter := tracingtest.Setup(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := jobstore.NewMemory()
+			for _, job := range jobsWithIDs("a", "b") {
+				repo.Upsert(context.Background(), job)
+			}
+			svc := newTestService(mock_httpclient.NewMockHttpClient(ctrl), repo, 0)
+			ctx, parent := otel.Tracer("test").Start(context.Background(), "ControllerImpl.GetJobs")
+
+			// Act
+			svc.FetchJobs(ctx, tt.query)
+			parent.End()
+
+			// Assert
+			span := tracingtest.Find(exporter.GetSpans(), "ServiceImpl.FetchJobs")
+			if span == nil {
+				t.Fatalf("Expected a ServiceImpl.FetchJobs span, got %+v", exporter.GetSpans())
+			}
+			if span.Parent.SpanID() != parent.SpanContext().SpanID() {
+				t.Errorf("Expected the span to be a child of the caller's span")
+			}
+			if span.Status.Code != tt.expectedStatus {
+				t.Errorf("Expected status %v, got %v", tt.expectedStatus, span.Status)
+			}
+			attrs := attribute.NewSet(span.Attributes...)
+			if returned, _ := attrs.Value("jobs.returned"); returned.AsInt64() != tt.expectReturned {
+				t.Errorf("Expected jobs.returned %d, got %v", tt.expectReturned, returned.Emit())
+			}
+		})
+	}
+}
+
+func TestServiceImpl_GetJob_Repository(t *testing.T) {
+	// Arrange: "stored" は保存済み、"new" は上流からのみ取得できる
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := jobstore.NewMemory()
+	repo.Upsert(context.Background(), model.Job{ID: "stored", Title: "Stored Job"})
+	mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+	mockClient.EXPECT().GetJob(gomock.Any(), "new").Return(&model.Job{ID: "new", Title: "New Job"}, nil).Times(1)
+	svc := newTestService(mockClient, repo, 0)
+	ctx := context.Background()
+
+	// Act
+	stored, err := svc.GetJob(ctx, "stored")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	fetched, err := svc.GetJob(ctx, "new")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	again, err := svc.GetJob(ctx, "new")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Assert: 上流から取得したJobは保存され、2回目は上流を呼ばない
+	if stored.Title != "Stored Job" || fetched.Title != "New Job" || again.Title != "New Job" {
+		t.Errorf("Unexpected jobs: %+v, %+v, %+v", stored, fetched, again)
+	}
+}
+
+func TestServiceImpl_DedupReport(t *testing.T) {
+	report := &dedup.Report{Jobs: 3, Merged: 1, Clusters: []dedup.Cluster{{
+		CanonicalID: "ats:1",
+		Members:     []dedup.Member{{ID: "ats:1"}, {ID: "feed:9"}},
+	}}}
+	tests := []struct {
+		name             string
+		report           *dedup.Report
+		jobID            string
+		expectedClusters int
+		expectedKind     apperr.Kind
+	}{
+		{name: "Success: Whole report", report: report, expectedClusters: 1},
+		{name: "Success: Cluster of a merged away job", report: report, jobID: "feed:9", expectedClusters: 1},
+		{name: "Error: Job was not merged", report: report, jobID: "other", expectedKind: apperr.NotFound},
+		{name: "Error: No ingestion run yet", expectedKind: apperr.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pipeline := mock_ingest.NewMockPipeline(ctrl)
+			pipeline.EXPECT().DedupReport().Return(tt.report)
+			svc := NewServiceImpl(jobstore.NewMemory(), pipeline, cursor.NewCodec("test-secret"), 0)
+
+			// Act
+			got, err := svc.DedupReport(context.Background(), tt.jobID)
+
+			// Assert
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(got.Clusters) != tt.expectedClusters || got.Jobs != 3 {
+				t.Errorf("Unexpected report: %+v", got)
+			}
+		})
+	}
+}
+
+func TestServiceImpl_Breakers(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expected := []httpclient.BreakerStatus{{Name: "greenhouse-acme", State: httpclient.BreakerOpen, ConsecutiveFailures: 5}}
+	pipeline := mock_ingest.NewMockPipeline(ctrl)
+	pipeline.EXPECT().Breakers().Return(expected)
+	svc := NewServiceImpl(jobstore.NewMemory(), pipeline, cursor.NewCodec("test-secret"), 0)
+
+	// Act
+	got := svc.Breakers(context.Background())
+
+	// Assert
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+// jobsWithIDs returns valid jobs with the given IDs
+func jobsWithIDs(ids ...string) []model.Job {
+	jobs := make([]model.Job, len(ids))
+	for i, id := range ids {
+		jobs[i] = model.Job{ID: id, Title: "Job " + id}
+	}
+	return jobs
+}
+
+// pageIDs returns the IDs of page's jobs in order
+func pageIDs(page *model.JobPage) []string {
+	ids := make([]string, len(page.Jobs))
+	for i, job := range page.Jobs {
+		ids[i] = job.ID
+	}
+	return ids
+}
+
+// assertIDs fails the test unless page holds exactly the expected job IDs in order
+func assertIDs(t *testing.T, page *model.JobPage, expected ...string) {
+	t.Helper()
+	got := pageIDs(page)
+	if len(got) != len(expected) {
+		t.Fatalf("Expected jobs %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Fatalf("Expected jobs %v, got %v", expected, got)
+		}
+	}
+}
+
+// newTestService creates a ServiceImpl whose pipeline pulls from client, as the "test" source, into repo
+func newTestService(client httpclient.HttpClient, repo repository.JobRepository, refreshInterval time.Duration) Service {
+	pipeline := ingest.NewPipeline(repo, []ingest.Source{ingest.NamedSource("test", client)}, nil)
+	return NewServiceImpl(repo, pipeline, cursor.NewCodec("test-secret"), refreshInterval)
+}
@@ -5,38 +5,99 @@ package controller
 import (
 	"context"
 
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/dedup"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/health"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/service"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 )
 
 // Controller is the interface for handling business logic coordination
 type Controller interface {
-	GetJobs(ctx context.Context) ([]model.Job, error)
+	GetJobs(ctx context.Context, query model.JobQuery) (*model.JobPage, error)
+	GetJob(ctx context.Context, id string) (*model.Job, error)
+	GetDedupReport(ctx context.Context, jobID string) (*dedup.Report, error)
+	GetBreakers(ctx context.Context) []httpclient.BreakerStatus
+	GetReadiness(ctx context.Context) health.Report
 }
 
 // ControllerImpl implements the Controller interface
 type ControllerImpl struct {
 	service service.Service
+	checker health.Checker
 }
 
 // NewController creates a new ControllerImpl
-func NewController(svc service.Service) Controller {
+func NewController(svc service.Service, checker health.Checker) Controller {
 	return &ControllerImpl{
 		service: svc,
+		checker: checker,
 	}
 }
 
 // GetJobs handles the job retrieval logic
-func (c *ControllerImpl) GetJobs(ctx context.Context) ([]model.Job, error) {
+func (c *ControllerImpl) GetJobs(ctx context.Context, query model.JobQuery) (page *model.JobPage, err error) {
+	ctx, span := tracing.Start(ctx, "ControllerImpl.GetJobs")
+	defer func() { tracing.End(span, err) }()
 	logger.Info(ctx, "Controller: GetJobs called")
 
-	jobs, err := c.service.FetchJobs(ctx)
+	page, err = c.service.FetchJobs(ctx, query)
 	if err != nil {
-		logger.Error(ctx, "Controller: Failed to fetch jobs from service")
+		logger.Error(ctx, "Controller: Failed to fetch jobs from service", zap.String("error_code", string(apperr.KindOf(err))), zap.Error(err))
 		return nil, err
 	}
 
 	logger.Info(ctx, "Controller: Successfully fetched jobs from service")
-	return jobs, nil
+	return page, nil
+}
+
+// GetJob handles the single job retrieval logic
+func (c *ControllerImpl) GetJob(ctx context.Context, id string) (job *model.Job, err error) {
+	ctx, span := tracing.Start(ctx, "ControllerImpl.GetJob", trace.WithAttributes(attribute.String("job.id", id)))
+	defer func() { tracing.End(span, err) }()
+	logger.Info(ctx, "Controller: GetJob called", zap.String("job_id", id))
+
+	job, err = c.service.GetJob(ctx, id)
+	if err != nil {
+		logger.Error(ctx, "Controller: Failed to fetch job from service", zap.String("error_code", string(apperr.KindOf(err))), zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info(ctx, "Controller: Successfully fetched job from service", zap.String("job_id", id))
+	return job, nil
+}
+
+// GetDedupReport handles the retrieval of the duplicate clusters of the last ingestion
+func (c *ControllerImpl) GetDedupReport(ctx context.Context, jobID string) (*dedup.Report, error) {
+	logger.Info(ctx, "Controller: GetDedupReport called", zap.String("job_id", jobID))
+
+	report, err := c.service.DedupReport(ctx, jobID)
+	if err != nil {
+		logger.Error(ctx, "Controller: Failed to fetch dedup report from service", zap.String("error_code", string(apperr.KindOf(err))), zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info(ctx, "Controller: Successfully fetched dedup report from service", zap.Int("clusters", len(report.Clusters)))
+	return report, nil
+}
+
+// GetBreakers handles the retrieval of the circuit breaker state of the sources
+func (c *ControllerImpl) GetBreakers(ctx context.Context) []httpclient.BreakerStatus {
+	logger.Info(ctx, "Controller: GetBreakers called")
+	return c.service.Breakers(ctx)
+}
+
+// GetReadiness handles the check of the dependencies the service needs to serve requests
+func (c *ControllerImpl) GetReadiness(ctx context.Context) health.Report {
+	report := c.checker.Readiness(ctx)
+	if report.Status != health.StatusUp {
+		logger.Warn(ctx, "Controller: Service is not fully ready", zap.String("status", string(report.Status)), zap.Any("components", report.Components))
+	}
+	return report
 }
@@ -3,10 +3,19 @@ package controller
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/dedup"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/health"
+	mock_health "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/health/mock"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
 	mock_service "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/service/mock"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/tracing/tracingtest"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
 	"go.uber.org/mock/gomock"
 )
 
@@ -16,12 +25,13 @@ func TestControllerImpl_GetJobs(t *testing.T) {
 		mockSetup     func(*mock_service.MockService)
 		expectedJobs  []model.Job
 		expectedError string
+		expectedKind  apperr.Kind
 		checkJobsNil  bool
 	}{
 		{
 			name: "Success: Multiple jobs are returned",
 			mockSetup: func(m *mock_service.MockService) {
-				m.EXPECT().FetchJobs(gomock.Any()).Return([]model.Job{
+				m.EXPECT().FetchJobs(gomock.Any(), gomock.Any()).Return(&model.JobPage{Jobs: []model.Job{
 					{
 						ID:          "1",
 						Title:       "Test Job 1",
@@ -36,7 +46,7 @@ func TestControllerImpl_GetJobs(t *testing.T) {
 						Location:    "Osaka",
 						Description: "Another Description",
 					},
-				}, nil)
+				}}, nil)
 			},
 			expectedJobs: []model.Job{
 				{
@@ -60,7 +70,7 @@ func TestControllerImpl_GetJobs(t *testing.T) {
 		{
 			name: "Success: Empty job list is returned",
 			mockSetup: func(m *mock_service.MockService) {
-				m.EXPECT().FetchJobs(gomock.Any()).Return([]model.Job{}, nil)
+				m.EXPECT().FetchJobs(gomock.Any(), gomock.Any()).Return(&model.JobPage{Jobs: []model.Job{}}, nil)
 			},
 			expectedJobs:  []model.Job{},
 			expectedError: "",
@@ -69,7 +79,7 @@ func TestControllerImpl_GetJobs(t *testing.T) {
 		{
 			name: "Error: Service returns error",
 			mockSetup: func(m *mock_service.MockService) {
-				m.EXPECT().FetchJobs(gomock.Any()).Return(nil, errors.New("service error occurred"))
+				m.EXPECT().FetchJobs(gomock.Any(), gomock.Any()).Return(nil, errors.New("service error occurred"))
 			},
 			expectedJobs:  nil,
 			expectedError: "service error occurred",
@@ -78,7 +88,7 @@ func TestControllerImpl_GetJobs(t *testing.T) {
 		{
 			name: "Success: Single job is returned",
 			mockSetup: func(m *mock_service.MockService) {
-				m.EXPECT().FetchJobs(gomock.Any()).Return([]model.Job{
+				m.EXPECT().FetchJobs(gomock.Any(), gomock.Any()).Return(&model.JobPage{Jobs: []model.Job{
 					{
 						ID:          "100",
 						Title:       "Single Job",
@@ -86,7 +96,7 @@ func TestControllerImpl_GetJobs(t *testing.T) {
 						Location:    "Nagoya",
 						Description: "Single description",
 					},
-				}, nil)
+				}}, nil)
 			},
 			expectedJobs: []model.Job{
 				{
@@ -103,7 +113,7 @@ func TestControllerImpl_GetJobs(t *testing.T) {
 		{
 			name: "Error: Database connection error",
 			mockSetup: func(m *mock_service.MockService) {
-				m.EXPECT().FetchJobs(gomock.Any()).Return(nil, errors.New("database connection failed"))
+				m.EXPECT().FetchJobs(gomock.Any(), gomock.Any()).Return(nil, errors.New("database connection failed"))
 			},
 			expectedJobs:  nil,
 			expectedError: "database connection failed",
@@ -122,7 +132,7 @@ func TestControllerImpl_GetJobs(t *testing.T) {
 						Description: "Description",
 					}
 				}
-				m.EXPECT().FetchJobs(gomock.Any()).Return(manyJobs, nil)
+				m.EXPECT().FetchJobs(gomock.Any(), gomock.Any()).Return(&model.JobPage{Jobs: manyJobs}, nil)
 			},
 			expectedJobs: func() []model.Job {
 				manyJobs := make([]model.Job, 100)
@@ -140,6 +150,16 @@ func TestControllerImpl_GetJobs(t *testing.T) {
 			expectedError: "",
 			checkJobsNil:  false,
 		},
+		{
+			name: "Error: Typed upstream error kind is preserved",
+			mockSetup: func(m *mock_service.MockService) {
+				m.EXPECT().FetchJobs(gomock.Any(), gomock.Any()).Return(nil, apperr.New(apperr.UpstreamTimeout, "upstream request timed out"))
+			},
+			expectedJobs:  nil,
+			expectedError: "upstream request timed out",
+			expectedKind:  apperr.UpstreamTimeout,
+			checkJobsNil:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -151,11 +171,11 @@ func TestControllerImpl_GetJobs(t *testing.T) {
 			mockService := mock_service.NewMockService(ctrl)
 			tt.mockSetup(mockService)
 
-			controller := NewController(mockService)
+			controller := NewController(mockService, nil)
 			ctx := context.Background()
 
 			// Act: テスト対象のメソッドを実行
-			jobs, err := controller.GetJobs(ctx)
+			page, err := controller.GetJobs(ctx, model.JobQuery{})
 
 			// Assert: エラーの検証
 			if tt.expectedError != "" {
@@ -165,6 +185,9 @@ func TestControllerImpl_GetJobs(t *testing.T) {
 				if err.Error() != tt.expectedError {
 					t.Errorf("Expected error '%s', got '%s'", tt.expectedError, err.Error())
 				}
+				if tt.expectedKind != "" && apperr.KindOf(err) != tt.expectedKind {
+					t.Errorf("Expected error kind '%s', got '%s'", tt.expectedKind, apperr.KindOf(err))
+				}
 			} else {
 				if err != nil {
 					t.Fatalf("Expected no error, got %v", err)
@@ -173,15 +196,16 @@ func TestControllerImpl_GetJobs(t *testing.T) {
 
 			// Assert: Jobsの検証
 			if tt.checkJobsNil {
-				if jobs != nil {
-					t.Errorf("Expected nil jobs, got %v", jobs)
+				if page != nil {
+					t.Errorf("Expected nil page, got %v", page)
 				}
 			} else {
+				jobs := page.Jobs
 				if len(jobs) != len(tt.expectedJobs) {
 					t.Fatalf("Expected %d jobs, got %d", len(tt.expectedJobs), len(jobs))
 				}
 				for i, expectedJob := range tt.expectedJobs {
-					if jobs[i] != expectedJob {
+					if !reflect.DeepEqual(jobs[i], expectedJob) {
 						t.Errorf("Job[%d] mismatch:\n  expected: %+v\n  got:      %+v", i, expectedJob, jobs[i])
 					}
 				}
@@ -189,3 +213,214 @@ func TestControllerImpl_GetJobs(t *testing.T) {
 		})
 	}
 }
+
+func TestControllerImpl_GetJobs_Span(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockSetup      func(*mock_service.MockService)
+		expectedStatus codes.Code
+	}{
+		{
+			name: "Success: Service runs inside the controller span",
+			mockSetup: func(m *mock_service.MockService) {
+				m.EXPECT().FetchJobs(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, query model.JobQuery) (*model.JobPage, error) {
+					_, span := otel.Tracer("test").Start(ctx, "ServiceImpl.FetchJobs")
+					span.End()
+					return &model.JobPage{}, nil
+				})
+			},
+			expectedStatus: codes.Unset,
+		},
+		{
+			name: "Error: Service error marks the span as failed",
+			mockSetup: func(m *mock_service.MockService) {
+				m.EXPECT().FetchJobs(gomock.Any(), gomock.Any()).Return(nil, apperr.New(apperr.UpstreamTimeout, "upstream request timed out"))
+			},
+			expectedStatus: codes.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			exporter := tracingtest.Setup(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockService := mock_service.NewMockService(ctrl)
+			tt.mockSetup(mockService)
+			controller := NewController(mockService, nil)
+
+			// Act
+			controller.GetJobs(context.Background(), model.JobQuery{})
+
+			// Assert
+			spans := exporter.GetSpans()
+			span := tracingtest.Find(spans, "ControllerImpl.GetJobs")
+			if span == nil {
+				t.Fatalf("Expected a ControllerImpl.GetJobs span, got %+v", spans)
+			}
+			if span.Status.Code != tt.expectedStatus {
+				t.Errorf("Expected status %v, got %v", tt.expectedStatus, span.Status)
+			}
+			if child := tracingtest.Find(spans, "ServiceImpl.FetchJobs"); child != nil && child.Parent.SpanID() != span.SpanContext.SpanID() {
+				t.Errorf("Expected the service span to be a child of the controller span")
+			}
+		})
+	}
+}
+
+func TestControllerImpl_GetJob(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockSetup    func(*mock_service.MockService)
+		expectedJob  *model.Job
+		expectedKind apperr.Kind
+	}{
+		{
+			name: "Success: Job is returned",
+			mockSetup: func(m *mock_service.MockService) {
+				m.EXPECT().GetJob(gomock.Any(), "1").Return(&model.Job{ID: "1", Title: "Test Job"}, nil)
+			},
+			expectedJob: &model.Job{ID: "1", Title: "Test Job"},
+		},
+		{
+			name: "Error: Service returns NotFound",
+			mockSetup: func(m *mock_service.MockService) {
+				m.EXPECT().GetJob(gomock.Any(), "1").Return(nil, apperr.New(apperr.NotFound, `job "1" not found`))
+			},
+			expectedKind: apperr.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockService := mock_service.NewMockService(ctrl)
+			tt.mockSetup(mockService)
+
+			controller := NewController(mockService, nil)
+
+			// Act
+			job, err := controller.GetJob(context.Background(), "1")
+
+			// Assert
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				if job != nil {
+					t.Errorf("Expected nil job, got %+v", job)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(*job, *tt.expectedJob) {
+				t.Errorf("Job mismatch:\n  expected: %+v\n  got:      %+v", *tt.expectedJob, *job)
+			}
+		})
+	}
+}
+
+func TestControllerImpl_GetDedupReport(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockSetup      func(*mock_service.MockService)
+		expectedReport *dedup.Report
+		expectedKind   apperr.Kind
+	}{
+		{
+			name: "Success: Report is returned",
+			mockSetup: func(m *mock_service.MockService) {
+				m.EXPECT().DedupReport(gomock.Any(), "ats:1").Return(&dedup.Report{Jobs: 2, Merged: 1}, nil)
+			},
+			expectedReport: &dedup.Report{Jobs: 2, Merged: 1},
+		},
+		{
+			name: "Error: Service returns NotFound",
+			mockSetup: func(m *mock_service.MockService) {
+				m.EXPECT().DedupReport(gomock.Any(), "ats:1").Return(nil, apperr.New(apperr.NotFound, "no ingestion run has completed yet"))
+			},
+			expectedKind: apperr.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockService := mock_service.NewMockService(ctrl)
+			tt.mockSetup(mockService)
+
+			controller := NewController(mockService, nil)
+
+			// Act
+			report, err := controller.GetDedupReport(context.Background(), "ats:1")
+
+			// Assert
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				if report != nil {
+					t.Errorf("Expected nil report, got %+v", report)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(*report, *tt.expectedReport) {
+				t.Errorf("Report mismatch:\n  expected: %+v\n  got:      %+v", *tt.expectedReport, *report)
+			}
+		})
+	}
+}
+
+func TestControllerImpl_GetBreakers(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expected := []httpclient.BreakerStatus{{Name: "lever-acme", State: httpclient.BreakerClosed}}
+	mockService := mock_service.NewMockService(ctrl)
+	mockService.EXPECT().Breakers(gomock.Any()).Return(expected)
+	controller := NewController(mockService, nil)
+
+	// Act
+	got := controller.GetBreakers(context.Background())
+
+	// Assert
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestControllerImpl_GetReadiness(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expected := health.Report{
+		Status:     health.StatusDegraded,
+		Components: []health.Component{{Name: "sources", Status: health.StatusDegraded, Detail: "circuit open for lever-acme"}},
+	}
+	mockChecker := mock_health.NewMockChecker(ctrl)
+	mockChecker.EXPECT().Readiness(gomock.Any()).Return(expected)
+	controller := NewController(mock_service.NewMockService(ctrl), mockChecker)
+
+	// Act
+	got := controller.GetReadiness(context.Background())
+
+	// Assert
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
@@ -13,7 +13,10 @@ import (
 	context "context"
 	reflect "reflect"
 
+	dedup "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/dedup"
+	health "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/health"
 	model "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	httpclient "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,17 +44,75 @@ func (m *MockController) EXPECT() *MockControllerMockRecorder {
 	return m.recorder
 }
 
+// GetBreakers mocks base method.
+func (m *MockController) GetBreakers(ctx context.Context) []httpclient.BreakerStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBreakers", ctx)
+	ret0, _ := ret[0].([]httpclient.BreakerStatus)
+	return ret0
+}
+
+// GetBreakers indicates an expected call of GetBreakers.
+func (mr *MockControllerMockRecorder) GetBreakers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBreakers", reflect.TypeOf((*MockController)(nil).GetBreakers), ctx)
+}
+
+// GetDedupReport mocks base method.
+func (m *MockController) GetDedupReport(ctx context.Context, jobID string) (*dedup.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDedupReport", ctx, jobID)
+	ret0, _ := ret[0].(*dedup.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDedupReport indicates an expected call of GetDedupReport.
+func (mr *MockControllerMockRecorder) GetDedupReport(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDedupReport", reflect.TypeOf((*MockController)(nil).GetDedupReport), ctx, jobID)
+}
+
+// GetJob mocks base method.
+func (m *MockController) GetJob(ctx context.Context, id string) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, id)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockControllerMockRecorder) GetJob(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockController)(nil).GetJob), ctx, id)
+}
+
 // GetJobs mocks base method.
-func (m *MockController) GetJobs(ctx context.Context) ([]model.Job, error) {
+func (m *MockController) GetJobs(ctx context.Context, query model.JobQuery) (*model.JobPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetJobs", ctx)
-	ret0, _ := ret[0].([]model.Job)
+	ret := m.ctrl.Call(m, "GetJobs", ctx, query)
+	ret0, _ := ret[0].(*model.JobPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetJobs indicates an expected call of GetJobs.
-func (mr *MockControllerMockRecorder) GetJobs(ctx any) *gomock.Call {
+func (mr *MockControllerMockRecorder) GetJobs(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobs", reflect.TypeOf((*MockController)(nil).GetJobs), ctx, query)
+}
+
+// GetReadiness mocks base method.
+func (m *MockController) GetReadiness(ctx context.Context) health.Report {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadiness", ctx)
+	ret0, _ := ret[0].(health.Report)
+	return ret0
+}
+
+// GetReadiness indicates an expected call of GetReadiness.
+func (mr *MockControllerMockRecorder) GetReadiness(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobs", reflect.TypeOf((*MockController)(nil).GetJobs), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadiness", reflect.TypeOf((*MockController)(nil).GetReadiness), ctx)
 }
@@ -0,0 +1,226 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"go.uber.org/zap"
+)
+
+// ErrCircuitOpen is returned without calling the upstream while its circuit breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// BreakerState is the state of a circuit breaker
+type BreakerState string
+
+const (
+	BreakerClosed   BreakerState = "closed"    // requests pass through
+	BreakerOpen     BreakerState = "open"      // requests fail fast
+	BreakerHalfOpen BreakerState = "half_open" // a few trial requests decide whether to close again
+)
+
+// BreakerConfig controls when a circuit breaker opens and closes
+type BreakerConfig struct {
+	FailureThreshold int           // consecutive failures that open the circuit
+	OpenTimeout      time.Duration // how long the circuit stays open before trial requests are let through
+	HalfOpenRequests int           // successful trial requests that close the circuit again
+}
+
+// BreakerStatus is a snapshot of a circuit breaker, for the status endpoint
+type BreakerStatus struct {
+	Name                string       `json:"name"`
+	State               BreakerState `json:"state"`
+	ConsecutiveFailures int          `json:"consecutive_failures"`
+	OpenedAt            *time.Time   `json:"opened_at,omitempty"`
+	RetryAt             *time.Time   `json:"retry_at,omitempty"` // when an open circuit lets the next trial request through
+	LastError           string       `json:"last_error,omitempty"`
+}
+
+// Breaker is a circuit breaker for one upstream.
+// Only upstream failures (unavailable, timeout, rate limited) count as failures, and only answers of the upstream, such as
+// a missing job, as successes. A request whose caller went away or that failed before reaching the upstream says nothing
+// about the upstream's health either way.
+type Breaker struct {
+	name string
+	cfg  BreakerConfig
+	now  func() time.Time
+
+	mu        sync.Mutex
+	state     BreakerState
+	failures  int
+	openedAt  time.Time
+	period    int // number of the current half-open period, so that trials of an earlier one are told apart
+	trials    int // trial requests in flight while half-open
+	successes int // successful trial requests while half-open
+	lastError string
+}
+
+// Permit is handed out by Allow to a request it lets through, and handed back to Done with the request's outcome
+type Permit struct {
+	trial  bool // the request was let through as a trial while half-open
+	period int  // the half-open period of the trial
+}
+
+// NewBreaker creates a closed Breaker
+func NewBreaker(name string, cfg BreakerConfig) *Breaker {
+	cfg.HalfOpenRequests = max(cfg.HalfOpenRequests, 1)
+	return &Breaker{name: name, cfg: cfg, now: time.Now, state: BreakerClosed}
+}
+
+// Name returns the name of the upstream
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// Allow reports whether a request may be sent. Every allowed request must be followed by a call to Done with the
+// returned Permit and its error.
+func (b *Breaker) Allow() (Permit, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= b.cfg.OpenTimeout {
+		b.state, b.trials, b.successes = BreakerHalfOpen, 0, 0
+		b.period++
+	}
+	switch b.state {
+	case BreakerOpen:
+		return Permit{}, apperr.Wrap(apperr.UpstreamUnavailable, fmt.Errorf("%w for %s until %s", ErrCircuitOpen, b.name, b.openedAt.Add(b.cfg.OpenTimeout).Format(time.RFC3339)), "")
+	case BreakerHalfOpen:
+		if b.trials >= b.cfg.HalfOpenRequests-b.successes {
+			return Permit{}, apperr.Wrap(apperr.UpstreamUnavailable, fmt.Errorf("%w for %s while trial requests are in flight", ErrCircuitOpen, b.name), "")
+		}
+		b.trials++
+		return Permit{trial: true, period: b.period}, nil
+	}
+	return Permit{}, nil
+}
+
+// Done records the outcome of a request that Allow let through.
+// While the circuit is not closed, only the trials of the current half-open period count: a request let through before
+// the circuit opened, or a trial of an earlier period, tells nothing about the upstream since then.
+func (b *Breaker) Done(ctx context.Context, p Permit, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	halfOpen := p.trial && p.period == b.period && b.state == BreakerHalfOpen
+	if halfOpen {
+		b.trials--
+	} else if b.state != BreakerClosed {
+		return
+	}
+	if isNeutral(ctx, err) {
+		// The trial slot is released for another request to prove the upstream healthy
+		return
+	}
+
+	if !isUpstreamFailure(err) {
+		b.failures = 0
+		if halfOpen {
+			b.successes++
+			if b.successes >= b.cfg.HalfOpenRequests {
+				b.state = BreakerClosed
+				logger.Info(ctx, "Circuit breaker closed", zap.String("source", b.name))
+			}
+		}
+		return
+	}
+
+	b.failures++
+	b.lastError = err.Error()
+	if halfOpen || (b.state == BreakerClosed && b.failures >= b.cfg.FailureThreshold) {
+		b.state, b.openedAt = BreakerOpen, b.now()
+		logger.Warn(ctx, "Circuit breaker opened", zap.String("source", b.name), zap.Int("consecutive_failures", b.failures), zap.Duration("open_timeout", b.cfg.OpenTimeout), zap.Error(err))
+	}
+}
+
+// Status returns a snapshot of the breaker
+func (b *Breaker) Status() BreakerStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	status := BreakerStatus{Name: b.name, State: b.state, ConsecutiveFailures: b.failures, LastError: b.lastError}
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= b.cfg.OpenTimeout {
+		status.State = BreakerHalfOpen
+	}
+	if !b.openedAt.IsZero() {
+		openedAt := b.openedAt
+		status.OpenedAt = &openedAt
+	}
+	if status.State == BreakerOpen {
+		retryAt := b.openedAt.Add(b.cfg.OpenTimeout)
+		status.RetryAt = &retryAt
+	}
+	return status
+}
+
+// isNeutral reports whether the outcome of a request tells nothing about the upstream: its ctx ended, or it failed
+// inside this process, as a canceled request does
+func isNeutral(ctx context.Context, err error) bool {
+	if err == nil {
+		return false
+	}
+	return ctx.Err() != nil || errors.Is(err, context.Canceled) || apperr.KindOf(err) == apperr.Internal
+}
+
+// isUpstreamFailure reports whether err says the upstream is unhealthy
+func isUpstreamFailure(err error) bool {
+	switch apperr.KindOf(err) {
+	case apperr.UpstreamUnavailable, apperr.UpstreamTimeout, apperr.RateLimited:
+		return !errors.Is(err, ErrCircuitOpen)
+	}
+	return false
+}
+
+// BreakerClient guards an HttpClient with a Breaker. It keeps the name of the breaker, so it can stand in for a named source.
+type BreakerClient struct {
+	client  HttpClient
+	breaker *Breaker
+}
+
+// NewBreakerClient wraps client with a new Breaker called name
+func NewBreakerClient(name string, client HttpClient, cfg BreakerConfig) *BreakerClient {
+	return &BreakerClient{client: client, breaker: NewBreaker(name, cfg)}
+}
+
+// Name returns the name of the breaker
+func (c *BreakerClient) Name() string {
+	return c.breaker.Name()
+}
+
+// Unwrap returns the guarded client
+func (c *BreakerClient) Unwrap() HttpClient {
+	return c.client
+}
+
+// BreakerStatus returns a snapshot of the breaker
+func (c *BreakerClient) BreakerStatus() BreakerStatus {
+	return c.breaker.Status()
+}
+
+// GetJobs fetches jobs through the breaker
+func (c *BreakerClient) GetJobs(ctx context.Context) ([]model.Job, error) {
+	permit, err := c.breaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := c.client.GetJobs(ctx)
+	c.breaker.Done(ctx, permit, err)
+	return jobs, err
+}
+
+// GetJob fetches a single job through the breaker
+func (c *BreakerClient) GetJob(ctx context.Context, id string) (*model.Job, error) {
+	permit, err := c.breaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+	job, err := c.client.GetJob(ctx, id)
+	c.breaker.Done(ctx, permit, err)
+	return job, err
+}
@@ -0,0 +1,267 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	mock_httpclient "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient/mock"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errUnavailable = apperr.New(apperr.UpstreamUnavailable, "upstream returned status 503")
+	errNotFound    = apperr.New(apperr.NotFound, "job not found")
+)
+
+func TestBreaker(t *testing.T) {
+	type step struct {
+		advance       time.Duration // time passed before the request
+		err           error         // outcome of the request, when it is allowed
+		expectAllowed bool
+		expectedState BreakerState // state after the request
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "Opens after consecutive failures and fails fast",
+			steps: []step{
+				{err: errUnavailable, expectAllowed: true, expectedState: BreakerClosed},
+				{err: errUnavailable, expectAllowed: true, expectedState: BreakerClosed},
+				{err: errUnavailable, expectAllowed: true, expectedState: BreakerOpen},
+				{expectAllowed: false, expectedState: BreakerOpen},
+			},
+		},
+		{
+			name: "Success resets the failure count",
+			steps: []step{
+				{err: errUnavailable, expectAllowed: true, expectedState: BreakerClosed},
+				{err: errUnavailable, expectAllowed: true, expectedState: BreakerClosed},
+				{expectAllowed: true, expectedState: BreakerClosed},
+				{err: errUnavailable, expectAllowed: true, expectedState: BreakerClosed},
+			},
+		},
+		{
+			name: "Not found does not count as a failure",
+			steps: []step{
+				{err: errNotFound, expectAllowed: true, expectedState: BreakerClosed},
+				{err: errNotFound, expectAllowed: true, expectedState: BreakerClosed},
+				{err: errNotFound, expectAllowed: true, expectedState: BreakerClosed},
+			},
+		},
+		{
+			name: "Closes after successful trial requests",
+			steps: []step{
+				{err: errUnavailable, expectAllowed: true},
+				{err: errUnavailable, expectAllowed: true},
+				{err: errUnavailable, expectAllowed: true, expectedState: BreakerOpen},
+				{advance: 30 * time.Second, expectAllowed: true, expectedState: BreakerHalfOpen},
+				{expectAllowed: true, expectedState: BreakerClosed},
+			},
+		},
+		{
+			name: "Reopens when a trial request fails",
+			steps: []step{
+				{err: errUnavailable, expectAllowed: true},
+				{err: errUnavailable, expectAllowed: true},
+				{err: errUnavailable, expectAllowed: true, expectedState: BreakerOpen},
+				{advance: 30 * time.Second, err: errUnavailable, expectAllowed: true, expectedState: BreakerOpen},
+				{advance: 10 * time.Second, expectAllowed: false, expectedState: BreakerOpen},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+			b := NewBreaker("greenhouse-acme", BreakerConfig{FailureThreshold: 3, OpenTimeout: 30 * time.Second, HalfOpenRequests: 2})
+			b.now = func() time.Time { return now }
+
+			for i, s := range tt.steps {
+				now = now.Add(s.advance)
+
+				// Act
+				permit, err := b.Allow()
+				if err == nil {
+					b.Done(context.Background(), permit, s.err)
+				}
+
+				// Assert
+				if (err == nil) != s.expectAllowed {
+					t.Fatalf("Step %d: expected allowed=%v, got error %v", i, s.expectAllowed, err)
+				}
+				if err != nil && (!errors.Is(err, ErrCircuitOpen) || apperr.KindOf(err) != apperr.UpstreamUnavailable) {
+					t.Fatalf("Step %d: expected an upstream_unavailable ErrCircuitOpen, got %v", i, err)
+				}
+				if s.expectedState != "" && b.Status().State != s.expectedState {
+					t.Fatalf("Step %d: expected state %s, got %s", i, s.expectedState, b.Status().State)
+				}
+			}
+		})
+	}
+}
+
+func TestBreaker_HalfOpenLimitsTrialRequests(t *testing.T) {
+	// Arrange: 開いた状態から OpenTimeout が経過
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	b := NewBreaker("lever-acme", BreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute, HalfOpenRequests: 1})
+	b.now = func() time.Time { return now }
+	permit, _ := b.Allow()
+	b.Done(context.Background(), permit, errUnavailable)
+	now = now.Add(time.Minute)
+
+	// Act: 試行リクエストの完了前に2件目が来る
+	_, first := b.Allow()
+	_, second := b.Allow()
+
+	// Assert
+	if first != nil {
+		t.Fatalf("Expected the trial request to be allowed, got %v", first)
+	}
+	if !errors.Is(second, ErrCircuitOpen) {
+		t.Fatalf("Expected the second request to fail fast, got %v", second)
+	}
+	status := b.Status()
+	if status.State != BreakerHalfOpen || status.OpenedAt == nil || status.LastError == "" {
+		t.Errorf("Unexpected status: %+v", status)
+	}
+}
+
+func TestBreaker_RequestsOutsideTheTrial(t *testing.T) {
+	// Arrange: 閉じている間に通ったリクエストが、開いて半開になるまで完了しない
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	b := NewBreaker("lever-acme", BreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute, HalfOpenRequests: 1})
+	b.now = func() time.Time { return now }
+	slow, _ := b.Allow()
+	failing, _ := b.Allow()
+	b.Done(context.Background(), failing, errUnavailable)
+	now = now.Add(time.Minute)
+	trial, err := b.Allow()
+	if err != nil {
+		t.Fatalf("Expected the trial request to be allowed, got %v", err)
+	}
+
+	// Act: 試行でないリクエストの成功は試行枠にも状態にも影響しない
+	b.Done(context.Background(), slow, nil)
+	_, whileTrial := b.Allow()
+	b.Done(context.Background(), trial, errUnavailable)
+	now = now.Add(time.Minute)
+	_, next := b.Allow()
+
+	// Assert
+	if !errors.Is(whileTrial, ErrCircuitOpen) {
+		t.Errorf("Expected a request during the trial to fail fast, got %v", whileTrial)
+	}
+	if next != nil {
+		t.Errorf("Expected a trial request of the next half-open period to be allowed, got %v", next)
+	}
+	if b.trials != 1 {
+		t.Errorf("Expected one trial in flight, got %d", b.trials)
+	}
+}
+
+func TestBreaker_NeutralOutcomes(t *testing.T) {
+	errCanceled := apperr.Wrap(apperr.Internal, context.Canceled, "upstream request canceled")
+
+	t.Run("Canceled trial does not close the circuit", func(t *testing.T) {
+		// Arrange: 半開状態
+		now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+		b := NewBreaker("lever-acme", BreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute, HalfOpenRequests: 1})
+		b.now = func() time.Time { return now }
+		failing, _ := b.Allow()
+		b.Done(context.Background(), failing, errUnavailable)
+		now = now.Add(time.Minute)
+		trial, _ := b.Allow()
+
+		// Act
+		b.Done(context.Background(), trial, errCanceled)
+		next, err := b.Allow()
+
+		// Assert: 試行枠だけが空き、半開のまま
+		if state := b.Status().State; state != BreakerHalfOpen {
+			t.Errorf("Expected the circuit to stay half-open, got %s", state)
+		}
+		if err != nil || !next.trial {
+			t.Errorf("Expected the released slot to admit another trial, got %+v, %v", next, err)
+		}
+	})
+
+	t.Run("Canceled request does not reset the failure count", func(t *testing.T) {
+		// Arrange
+		b := NewBreaker("lever-acme", BreakerConfig{FailureThreshold: 3, OpenTimeout: time.Minute, HalfOpenRequests: 1})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		for _, outcome := range []struct {
+			ctx context.Context
+			err error
+		}{
+			{context.Background(), errUnavailable},
+			{context.Background(), errUnavailable},
+			{context.Background(), errCanceled},
+			{ctx, apperr.New(apperr.UpstreamTimeout, "upstream request timed out")},
+		} {
+			p, _ := b.Allow()
+
+			// Act
+			b.Done(outcome.ctx, p, outcome.err)
+		}
+
+		// Assert: 上流の失敗だけが数えられる
+		if status := b.Status(); status.State != BreakerClosed || status.ConsecutiveFailures != 2 {
+			t.Errorf("Expected 2 consecutive failures on a closed circuit, got %+v", status)
+		}
+	})
+}
+
+func TestBreakerClient(t *testing.T) {
+	// Arrange: 上流が2回失敗すると開く
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+	mockClient.EXPECT().GetJobs(gomock.Any()).Return(nil, apperr.New(apperr.UpstreamTimeout, "upstream request timed out")).Times(1)
+	mockClient.EXPECT().GetJob(gomock.Any(), "1").Return(nil, errUnavailable).Times(1)
+	client := NewBreakerClient("workable-acme", mockClient, BreakerConfig{FailureThreshold: 2, OpenTimeout: time.Minute})
+	ctx := context.Background()
+
+	// Act
+	client.GetJobs(ctx)
+	client.GetJob(ctx, "1")
+	jobs, err := client.GetJobs(ctx)
+
+	// Assert: 3回目は上流を呼ばずに失敗する
+	if !errors.Is(err, ErrCircuitOpen) || jobs != nil {
+		t.Fatalf("Expected ErrCircuitOpen, got %v, %v", jobs, err)
+	}
+	status := client.BreakerStatus()
+	if client.Name() != "workable-acme" || status.State != BreakerOpen || status.ConsecutiveFailures != 2 || status.RetryAt == nil {
+		t.Errorf("Unexpected status: %+v", status)
+	}
+}
+
+func TestBreakerClient_PassesThrough(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+	mockClient.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{{ID: "1"}}, nil)
+	client := NewBreakerClient("api", mockClient, BreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute})
+
+	// Act
+	jobs, err := client.GetJobs(context.Background())
+
+	// Assert
+	if err != nil || len(jobs) != 1 {
+		t.Fatalf("Expected 1 job, got %v, %v", jobs, err)
+	}
+	if client.BreakerStatus().State != BreakerClosed {
+		t.Errorf("Expected closed breaker, got %s", client.BreakerStatus().State)
+	}
+}
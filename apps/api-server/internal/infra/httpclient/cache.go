@@ -0,0 +1,279 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+// CacheConfig controls how long CachingClient serves a response
+type CacheConfig struct {
+	Name                 string        // source label of the cache metrics
+	TTL                  time.Duration // how long a response is served without asking the upstream
+	StaleWhileRevalidate time.Duration // after the TTL, how long a response is still served while it is refreshed in the background
+	StaleIfError         time.Duration // after the TTL, how long a response is still served when the upstream fails
+	PersistDir           string        // directory the cache is saved to and loaded from, so that it survives cold starts; empty keeps it in memory only
+}
+
+// cacheEntry is a cached response of GetJobs or GetJob
+type cacheEntry struct {
+	Jobs      []model.Job `json:"jobs,omitempty"`
+	Job       *model.Job  `json:"job,omitempty"`
+	FetchedAt time.Time   `json:"fetched_at"`
+}
+
+// CachingClient caches the responses of another HttpClient.
+// A response younger than the TTL is served from the cache. Within the stale-while-revalidate window after it, the cached
+// response is served at once and refreshed in the background; within the stale-if-error window, it is served only when the
+// upstream fails. Concurrent requests for the same key share one upstream call.
+type CachingClient struct {
+	client HttpClient
+	cfg    CacheConfig
+	now    func() time.Time
+	group  singleflight.Group
+
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+
+	persistMu   sync.Mutex
+	revalidates sync.WaitGroup // background refreshes in flight
+}
+
+// NewCachingClient wraps client with a cache. A persisted cache in cfg.PersistDir is loaded when it exists.
+func NewCachingClient(client HttpClient, cfg CacheConfig) *CachingClient {
+	c := &CachingClient{client: client, cfg: cfg, now: time.Now, entries: make(map[string]cacheEntry)}
+	if cfg.PersistDir != "" {
+		if err := c.load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+			logger.Warn(context.Background(), "Ignoring unreadable upstream cache", zap.String("path", cfg.PersistDir), zap.Error(err))
+		}
+	}
+	return c
+}
+
+// Unwrap returns the wrapped client
+func (c *CachingClient) Unwrap() HttpClient {
+	return c.client
+}
+
+// GetJobs returns the job list from the cache or the upstream
+func (c *CachingClient) GetJobs(ctx context.Context) ([]model.Job, error) {
+	entry, err := c.get(ctx, "jobs", func(ctx context.Context) (cacheEntry, error) {
+		jobs, err := c.client.GetJobs(ctx)
+		return cacheEntry{Jobs: jobs}, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Callers normalize the jobs in place, so they get copies
+	jobs := make([]model.Job, len(entry.Jobs))
+	for i, job := range entry.Jobs {
+		jobs[i] = job.Clone()
+	}
+	return jobs, nil
+}
+
+// GetJob returns a single job from the cache or the upstream. Errors, including not found, are not cached; neither is an
+// upstream answering without a job, which is not found as well.
+func (c *CachingClient) GetJob(ctx context.Context, id string) (*model.Job, error) {
+	entry, err := c.get(ctx, "job:"+id, func(ctx context.Context) (cacheEntry, error) {
+		job, err := c.client.GetJob(ctx, id)
+		if err == nil && job == nil {
+			err = jobNotFound(id)
+		}
+		return cacheEntry{Job: job}, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if entry.Job == nil {
+		return nil, jobNotFound(id)
+	}
+	job := entry.Job.Clone()
+	return &job, nil
+}
+
+// get serves key from the cache according to its age, fetching it with fetch when needed
+func (c *CachingClient) get(ctx context.Context, key string, fetch func(context.Context) (cacheEntry, error)) (cacheEntry, error) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	var age time.Duration
+	if ok {
+		age = c.now().Sub(entry.FetchedAt)
+		switch {
+		case age < c.cfg.TTL:
+			logger.Debug(ctx, "Serving upstream response from cache", zap.String("key", key), zap.Duration("age", age))
+			c.recordLookup("hit")
+			return entry, nil
+		case age < c.cfg.TTL+c.cfg.StaleWhileRevalidate:
+			logger.Debug(ctx, "Serving stale upstream response while revalidating", zap.String("key", key), zap.Duration("age", age))
+			c.recordLookup("stale")
+			c.revalidate(ctx, key, fetch)
+			return entry, nil
+		}
+	}
+	c.recordLookup("miss")
+
+	fresh, err := c.fetch(ctx, key, fetch)
+	if err == nil {
+		return fresh, nil
+	}
+	if ok && age < c.cfg.TTL+c.cfg.StaleIfError {
+		logger.Warn(ctx, "Serving stale upstream response because the upstream failed", zap.String("key", key), zap.Duration("age", age), zap.Error(err))
+		return entry, nil
+	}
+	return cacheEntry{}, err
+}
+
+// jobNotFound is the error of a job that the upstream does not have
+func jobNotFound(id string) error {
+	return apperr.New(apperr.NotFound, fmt.Sprintf("job %q not found", id))
+}
+
+// recordLookup counts a cache lookup with result hit, stale or miss
+func (c *CachingClient) recordLookup(result string) {
+	metrics.Add(metrics.CacheLookupsTotal, 1, metrics.L("source", c.cfg.Name), metrics.L("result", result))
+}
+
+// fetch calls the upstream once for all concurrent callers of key and caches the result.
+// Every caller waits only as long as its own ctx allows.
+func (c *CachingClient) fetch(ctx context.Context, key string, fetch func(context.Context) (cacheEntry, error)) (cacheEntry, error) {
+	ch := c.group.DoChan(key, func() (any, error) {
+		// The shared call must not fail because the caller that happened to start it went away
+		entry, err := fetch(context.WithoutCancel(ctx))
+		if err != nil {
+			return cacheEntry{}, err
+		}
+		entry.FetchedAt = c.now()
+		c.store(ctx, key, entry)
+		return entry, nil
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return cacheEntry{}, res.Err
+		}
+		return res.Val.(cacheEntry), nil
+	case <-ctx.Done():
+		return cacheEntry{}, classifyTransportError(ctx, ctx.Err())
+	}
+}
+
+// revalidate refreshes key in the background. Failures keep the cached entry.
+func (c *CachingClient) revalidate(ctx context.Context, key string, fetch func(context.Context) (cacheEntry, error)) {
+	c.revalidates.Add(1)
+	go func() {
+		defer c.revalidates.Done()
+		if _, err := c.fetch(context.WithoutCancel(ctx), key, fetch); err != nil {
+			logger.Warn(ctx, "Failed to revalidate upstream response", zap.String("key", key), zap.Error(err))
+		}
+	}()
+}
+
+// store caches entry under key and persists it when configured
+func (c *CachingClient) store(ctx context.Context, key string, entry cacheEntry) {
+	c.mu.Lock()
+	c.entries[key] = entry
+	// Entries too old to be served in any way are dropped, so that single job lookups do not pile up
+	var dropped []string
+	for k, e := range c.entries {
+		if c.now().Sub(e.FetchedAt) >= c.cfg.TTL+max(c.cfg.StaleWhileRevalidate, c.cfg.StaleIfError) {
+			delete(c.entries, k)
+			dropped = append(dropped, k)
+		}
+	}
+	c.mu.Unlock()
+
+	if c.cfg.PersistDir != "" {
+		if err := c.persist(key, entry, dropped); err != nil {
+			logger.Warn(ctx, "Failed to persist upstream cache", zap.String("path", c.cfg.PersistDir), zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+// persist writes entry to the file of key and removes the files of the dropped keys. Every key has a file of its own,
+// so that caching a single job does not rewrite the job list. The file is replaced atomically so that a concurrent cold
+// start never reads half of it.
+func (c *CachingClient) persist(key string, entry cacheEntry, dropped []string) error {
+	c.persistMu.Lock()
+	defer c.persistMu.Unlock()
+
+	for _, k := range dropped {
+		if err := os.Remove(c.entryPath(k)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.cfg.PersistDir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(c.cfg.PersistDir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.entryPath(key))
+}
+
+// entryPath returns the file an entry is persisted to
+func (c *CachingClient) entryPath(key string) string {
+	return filepath.Join(c.cfg.PersistDir, url.PathEscape(key)+".json")
+}
+
+// load reads the entries persisted in PersistDir. Unreadable entries are skipped, so that one bad file does not lose the others.
+func (c *CachingClient) load() error {
+	files, err := os.ReadDir(c.cfg.PersistDir)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, file := range files {
+		name, ok := strings.CutSuffix(file.Name(), ".json")
+		if !ok || file.IsDir() {
+			continue
+		}
+		key, err := url.PathUnescape(name)
+		if err != nil {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(c.cfg.PersistDir, file.Name()))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		var entry cacheEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			errs = append(errs, fmt.Errorf("failed to decode upstream cache entry %q: %w", key, err))
+			continue
+		}
+		c.entries[key] = entry
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,305 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	mock_httpclient "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient/mock"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics/metricstest"
+	"go.uber.org/mock/gomock"
+)
+
+var testCacheConfig = CacheConfig{Name: "test", TTL: time.Minute, StaleWhileRevalidate: 5 * time.Minute, StaleIfError: time.Hour}
+
+func TestCachingClient_GetJobs(t *testing.T) {
+	oldJobs := []model.Job{{ID: "1", Title: "Old Title"}}
+	newJobs := []model.Job{{ID: "1", Title: "New Title"}}
+	tests := []struct {
+		name          string
+		age           time.Duration // age of the cached response; zero means nothing is cached
+		mockSetup     func(m *mock_httpclient.MockHttpClient)
+		expectedTitle string
+		expectedKind  apperr.Kind
+		expectCached  string // title cached after background revalidation
+		expectLookup  string // result of the cache lookup metric
+	}{
+		{
+			name: "Miss: Upstream is called",
+			mockSetup: func(m *mock_httpclient.MockHttpClient) {
+				m.EXPECT().GetJobs(gomock.Any()).Return(newJobs, nil)
+			},
+			expectedTitle: "New Title",
+			expectLookup:  "miss",
+		},
+		{
+			name:          "Fresh: Served without calling the upstream",
+			age:           30 * time.Second,
+			mockSetup:     func(m *mock_httpclient.MockHttpClient) {},
+			expectedTitle: "Old Title",
+			expectLookup:  "hit",
+		},
+		{
+			name: "Stale while revalidate: Served at once and refreshed in the background",
+			age:  2 * time.Minute,
+			mockSetup: func(m *mock_httpclient.MockHttpClient) {
+				m.EXPECT().GetJobs(gomock.Any()).Return(newJobs, nil)
+			},
+			expectedTitle: "Old Title",
+			expectCached:  "New Title",
+			expectLookup:  "stale",
+		},
+		{
+			name: "Expired: Upstream is called",
+			age:  10 * time.Minute,
+			mockSetup: func(m *mock_httpclient.MockHttpClient) {
+				m.EXPECT().GetJobs(gomock.Any()).Return(newJobs, nil)
+			},
+			expectedTitle: "New Title",
+			expectLookup:  "miss",
+		},
+		{
+			name: "Stale if error: Served when the upstream fails",
+			age:  10 * time.Minute,
+			mockSetup: func(m *mock_httpclient.MockHttpClient) {
+				m.EXPECT().GetJobs(gomock.Any()).Return(nil, apperr.Wrap(apperr.UpstreamUnavailable, ErrCircuitOpen, ""))
+			},
+			expectedTitle: "Old Title",
+			expectLookup:  "miss",
+		},
+		{
+			name: "Error: Too old to be served when the upstream fails",
+			age:  2 * time.Hour,
+			mockSetup: func(m *mock_httpclient.MockHttpClient) {
+				m.EXPECT().GetJobs(gomock.Any()).Return(nil, apperr.New(apperr.UpstreamTimeout, "upstream request timed out"))
+			},
+			expectedKind: apperr.UpstreamTimeout,
+			expectLookup: "miss",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			recorder := metricstest.Setup(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+			tt.mockSetup(mockClient)
+
+			now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+			c := NewCachingClient(mockClient, testCacheConfig)
+			c.now = func() time.Time { return now }
+			if tt.age > 0 {
+				c.entries["jobs"] = cacheEntry{Jobs: oldJobs, FetchedAt: now.Add(-tt.age)}
+			}
+
+			// Act
+			jobs, err := c.GetJobs(context.Background())
+			c.revalidates.Wait()
+
+			// Assert
+			if got := recorder.Counter(metrics.CacheLookupsTotal, metrics.L("source", "test"), metrics.L("result", tt.expectLookup)); got != 1 {
+				t.Errorf("Expected 1 %s lookup, got %v", tt.expectLookup, got)
+			}
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(jobs) != 1 || jobs[0].Title != tt.expectedTitle {
+				t.Errorf("Expected '%s', got %+v", tt.expectedTitle, jobs)
+			}
+			if tt.expectCached != "" && c.entries["jobs"].Jobs[0].Title != tt.expectCached {
+				t.Errorf("Expected the cache to be revalidated to '%s', got %+v", tt.expectCached, c.entries["jobs"])
+			}
+		})
+	}
+}
+
+func TestCachingClient_GetJobs_ReturnsCopies(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+	mockClient.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{{ID: "1", Title: "Backend Engineer"}}, nil).Times(1)
+	c := NewCachingClient(mockClient, testCacheConfig)
+
+	// Act: 呼び出し元が正規化で書き換える
+	first, _ := c.GetJobs(context.Background())
+	first[0].Source = "greenhouse"
+	second, _ := c.GetJobs(context.Background())
+
+	// Assert
+	if second[0].Source != "" {
+		t.Errorf("Expected the cached job to be unaffected, got source '%s'", second[0].Source)
+	}
+}
+
+func TestCachingClient_GetJobs_Singleflight(t *testing.T) {
+	// Arrange: 上流は呼び出されたことを entered で知らせ、release が閉じられるまで応答しない
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+	mockClient.EXPECT().GetJobs(gomock.Any()).DoAndReturn(func(ctx context.Context) ([]model.Job, error) {
+		close(entered)
+		<-release
+		return []model.Job{{ID: "1", Title: "Backend Engineer"}}, nil
+	}).Times(1)
+	c := NewCachingClient(mockClient, testCacheConfig)
+
+	// Act: 最初の呼び出しが上流で止まってから、残りの呼び出しを始める
+	var wg sync.WaitGroup
+	results := make([][]model.Job, 10)
+	call := func(i int, started chan<- struct{}) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if started != nil {
+				close(started)
+			}
+			results[i], _ = c.GetJobs(context.Background())
+		}()
+	}
+	call(0, nil)
+	<-entered
+	for i := 1; i < len(results); i++ {
+		started := make(chan struct{})
+		call(i, started)
+		<-started
+	}
+	close(release)
+	wg.Wait()
+
+	// Assert: 上流の呼び出しは1回だけで、全員が結果を受け取る
+	for i, jobs := range results {
+		if len(jobs) != 1 {
+			t.Errorf("Caller %d: expected 1 job, got %v", i, jobs)
+		}
+	}
+}
+
+func TestCachingClient_GetJob(t *testing.T) {
+	// Arrange: 404 はキャッシュしない
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+	gomock.InOrder(
+		mockClient.EXPECT().GetJob(gomock.Any(), "1").Return(nil, apperr.New(apperr.NotFound, "job not found")),
+		mockClient.EXPECT().GetJob(gomock.Any(), "1").Return(&model.Job{ID: "1", Title: "Backend Engineer"}, nil),
+	)
+	c := NewCachingClient(mockClient, testCacheConfig)
+	ctx := context.Background()
+
+	// Act
+	_, notFound := c.GetJob(ctx, "1")
+	job, err := c.GetJob(ctx, "1")
+	cached, cachedErr := c.GetJob(ctx, "1")
+
+	// Assert
+	if !apperr.Is(notFound, apperr.NotFound) {
+		t.Fatalf("Expected NotFound, got %v", notFound)
+	}
+	if err != nil || cachedErr != nil {
+		t.Fatalf("Expected no error, got %v, %v", err, cachedErr)
+	}
+	if job.Title != "Backend Engineer" || cached.Title != "Backend Engineer" {
+		t.Errorf("Unexpected jobs: %+v, %+v", job, cached)
+	}
+}
+
+func TestCachingClient_GetJob_NoJob(t *testing.T) {
+	// Arrange: 上流がエラーなしで Job を返さない
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+	mockClient.EXPECT().GetJob(gomock.Any(), "1").Return(nil, nil).Times(2)
+	c := NewCachingClient(mockClient, testCacheConfig)
+	ctx := context.Background()
+
+	// Act
+	job, err := c.GetJob(ctx, "1")
+	_, again := c.GetJob(ctx, "1")
+
+	// Assert: NotFound として扱い、キャッシュしない
+	if job != nil || !apperr.Is(err, apperr.NotFound) || !apperr.Is(again, apperr.NotFound) {
+		t.Errorf("Expected NotFound twice, got %+v, %v, %v", job, err, again)
+	}
+}
+
+func TestCachingClient_Persist(t *testing.T) {
+	// Arrange: 1つ目のクライアントが取得した結果をファイルに保存
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := testCacheConfig
+	cfg.PersistDir = filepath.Join(t.TempDir(), "cache", "greenhouse-acme")
+	warm := mock_httpclient.NewMockHttpClient(ctrl)
+	warm.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{{ID: "1", Title: "Backend Engineer"}}, nil)
+	if _, err := NewCachingClient(warm, cfg).GetJobs(context.Background()); err != nil {
+		t.Fatalf("Failed to warm the cache: %v", err)
+	}
+
+	// Act: コールドスタートを想定し、新しいクライアントで読み込む
+	cold := mock_httpclient.NewMockHttpClient(ctrl)
+	jobs, err := NewCachingClient(cold, cfg).GetJobs(context.Background())
+
+	// Assert: 上流を呼ばずにファイルから返す
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(jobs) != 1 || jobs[0].Title != "Backend Engineer" {
+		t.Errorf("Expected the persisted job, got %+v", jobs)
+	}
+}
+
+func TestCachingClient_Persist_PerKey(t *testing.T) {
+	// Arrange: 一覧を保存した後、そのファイルを消す
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := testCacheConfig
+	cfg.PersistDir = t.TempDir()
+	mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+	mockClient.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{{ID: "1", Title: "Backend Engineer"}}, nil)
+	mockClient.EXPECT().GetJob(gomock.Any(), "lever-acme:1/2").Return(&model.Job{ID: "lever-acme:1/2", Title: "SRE"}, nil)
+	c := NewCachingClient(mockClient, cfg)
+	if _, err := c.GetJobs(context.Background()); err != nil {
+		t.Fatalf("Failed to warm the cache: %v", err)
+	}
+	if err := os.Remove(filepath.Join(cfg.PersistDir, "jobs.json")); err != nil {
+		t.Fatalf("Expected the job list to be persisted: %v", err)
+	}
+
+	// Act
+	if _, err := c.GetJob(context.Background(), "lever-acme:1/2"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	job, err := NewCachingClient(mock_httpclient.NewMockHttpClient(ctrl), cfg).GetJob(context.Background(), "lever-acme:1/2")
+
+	// Assert: 1件の Job を保存しても一覧は書き直さず、Job は自身のファイルから復元される
+	if _, err := os.Stat(filepath.Join(cfg.PersistDir, "jobs.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected the job list not to be rewritten, got %v", err)
+	}
+	if err != nil || job.Title != "SRE" {
+		t.Errorf("Expected the persisted job, got %+v, %v", job, err)
+	}
+}
@@ -4,17 +4,45 @@ package httpclient
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
-	"time"
+	"net/url"
 
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/config"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"go.uber.org/zap"
 )
 
+var (
+	// ErrTimeout is returned when the upstream does not respond within the configured timeout
+	ErrTimeout = errors.New("upstream request timed out")
+	// ErrMalformedResponse is returned when the upstream body cannot be decoded
+	ErrMalformedResponse = errors.New("upstream returned a malformed response")
+)
+
+// StatusError is returned when the upstream responds with a non-2xx status code
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("upstream returned status %d", e.StatusCode)
+}
+
+// maxErrorBodySize limits how much of a non-2xx body is kept for diagnostics
+const maxErrorBodySize = 4 << 10
+
 // HttpClient is an interface for external API calls
 type HttpClient interface {
 	GetJobs(ctx context.Context) ([]model.Job, error)
+	GetJob(ctx context.Context, id string) (*model.Job, error)
 }
 
 // ClientImpl is the implementation of HttpClient
@@ -26,34 +54,129 @@ type ClientImpl struct {
 // New creates a new HttpClient implementation
 func New(cfg *config.Config) HttpClient {
 	return &ClientImpl{
-		Endpoint: cfg.ApiEndpoint,
-		HTTPClient: &http.Client{
-			Timeout: time.Duration(cfg.ApiTimeout) * time.Second,
-		},
+		Endpoint:   cfg.ApiEndpoint,
+		HTTPClient: NewHTTPClient(cfg),
 	}
 }
 
-// GetJobs fetches jobs from external API (dummy implementation)
+// GetJobs fetches jobs from the external API
 func (c *ClientImpl) GetJobs(ctx context.Context) ([]model.Job, error) {
-	// This is a dummy implementation
-	// In real implementation, you would make HTTP request to c.Endpoint
-	jobs := []model.Job{
-		{
-			ID:          "1",
-			Title:       "Senior Go Developer",
-			Company:     "Tech Company A",
-			Location:    "Tokyo, Japan",
-			Description: "Looking for an experienced Go developer",
-		},
-		{
-			ID:          "2",
-			Title:       "Backend Engineer",
-			Company:     "Startup B",
-			Location:    "Osaka, Japan",
-			Description: "Join our growing team",
-		},
-	}
-
-	fmt.Printf("Fetching jobs from: %s\n", c.Endpoint)
+	logger.Debug(ctx, "Fetching jobs from upstream", zap.String("endpoint", c.Endpoint))
+
+	var jobs []model.Job
+	if err := GetJSON(ctx, c.HTTPClient, c.Endpoint, &jobs); err != nil {
+		return nil, err
+	}
+
 	return jobs, nil
 }
+
+// GetJob fetches a single job from the external API at {Endpoint}/{id}
+func (c *ClientImpl) GetJob(ctx context.Context, id string) (*model.Job, error) {
+	jobURL, err := url.JoinPath(c.Endpoint, url.PathEscape(id))
+	if err != nil {
+		return nil, apperr.Wrap(apperr.Internal, err, "failed to build job URL")
+	}
+
+	logger.Debug(ctx, "Fetching job from upstream", zap.String("endpoint", jobURL))
+
+	var job model.Job
+	if err := GetJSON(ctx, c.HTTPClient, jobURL, &job); err != nil {
+		return nil, JobNotFound(err, id)
+	}
+
+	return &job, nil
+}
+
+// GetJSON sends a GET request to rawURL with client and decodes the JSON response body into v.
+// Every returned error is an *apperr.Error wrapping the underlying cause, so other upstream clients classify failures the same way.
+func GetJSON(ctx context.Context, client *http.Client, rawURL string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return apperr.Wrap(apperr.Internal, err, "failed to build upstream request")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := Do(client, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return BodyError(ctx, err)
+	}
+
+	return nil
+}
+
+// Do sends req with client and returns the response when the status is 2xx or 304 Not Modified.
+// Transport failures and other statuses are returned as *apperr.Error, with the response body closed.
+func Do(client *http.Client, req *http.Request) (*http.Response, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, classifyTransportError(req.Context(), err)
+	}
+
+	if (resp.StatusCode < 200 || resp.StatusCode > 299) && resp.StatusCode != http.StatusNotModified {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		statusErr := &StatusError{StatusCode: resp.StatusCode, Body: string(body)}
+		return nil, apperr.Wrap(kindForStatus(resp.StatusCode), statusErr, "upstream request failed")
+	}
+
+	return resp, nil
+}
+
+// BodyError classifies an error that occurred while reading or decoding a response body
+func BodyError(ctx context.Context, err error) error {
+	// A deadline can also fire while the body is still being read
+	if isTimeout(ctx, err) {
+		return apperr.Wrap(apperr.UpstreamTimeout, fmt.Errorf("%w: %v", ErrTimeout, err), "")
+	}
+	return apperr.Wrap(apperr.UpstreamUnavailable, fmt.Errorf("%w: %v", ErrMalformedResponse, err), "")
+}
+
+// classifyTransportError converts errors returned by http.Client.Do into typed errors
+func classifyTransportError(ctx context.Context, err error) error {
+	if isTimeout(ctx, err) {
+		return apperr.Wrap(apperr.UpstreamTimeout, fmt.Errorf("%w: %v", ErrTimeout, err), "")
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return apperr.Wrap(apperr.Internal, ctxErr, "upstream request canceled")
+	}
+	return apperr.Wrap(apperr.UpstreamUnavailable, err, "upstream request failed")
+}
+
+// kindForStatus maps an upstream status code to an error kind.
+// A 404 is an upstream failure like any other, since a missing list endpoint is a misconfiguration rather than an
+// answer; single-job requests turn it into NotFound with JobNotFound.
+func kindForStatus(statusCode int) apperr.Kind {
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return apperr.RateLimited
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return apperr.UpstreamTimeout
+	default:
+		return apperr.UpstreamUnavailable
+	}
+}
+
+// JobNotFound converts the upstream 404 of a single-job request into an apperr.NotFound error, since only there does a
+// 404 mean that the job does not exist. Other errors are returned unchanged.
+func JobNotFound(err error, id string) error {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+		return apperr.Wrap(apperr.NotFound, err, fmt.Sprintf("job %q not found", id))
+	}
+	return err
+}
+
+// isTimeout reports whether err was caused by a client timeout or an expired ctx deadline
+func isTimeout(ctx context.Context, err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
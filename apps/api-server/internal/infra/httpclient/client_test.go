@@ -0,0 +1,242 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/config"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+func TestClientImpl_GetJobs(t *testing.T) {
+	tests := []struct {
+		name               string
+		handler            http.HandlerFunc
+		timeout            time.Duration
+		expectedJobs       []model.Job
+		expectedErr        error
+		expectedStatusCode int
+		expectedKind       apperr.Kind
+	}{
+		{
+			name: "Success: Jobs are decoded from upstream JSON",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`[
+					{"id":"1","title":"Senior Go Developer","company":"Tech Company A","location":"Tokyo, Japan","description":"Go"},
+					{"id":"2","title":"Backend Engineer","company":"Startup B","location":"Osaka, Japan","description":"Backend"}
+				]`))
+			},
+			expectedJobs: []model.Job{
+				{ID: "1", Title: "Senior Go Developer", Company: "Tech Company A", Location: "Tokyo, Japan", Description: "Go"},
+				{ID: "2", Title: "Backend Engineer", Company: "Startup B", Location: "Osaka, Japan", Description: "Backend"},
+			},
+		},
+		{
+			name: "Success: Empty list is returned",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`[]`))
+			},
+			expectedJobs: []model.Job{},
+		},
+		{
+			name: "Error: Upstream returns 500",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "boom", http.StatusInternalServerError)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedKind:       apperr.UpstreamUnavailable,
+		},
+		{
+			name: "Error: Upstream 404 of the list is an upstream failure",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.NotFound(w, r)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedKind:       apperr.UpstreamUnavailable,
+		},
+		{
+			name: "Error: Upstream returns 429",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusTooManyRequests)
+			},
+			expectedStatusCode: http.StatusTooManyRequests,
+			expectedKind:       apperr.RateLimited,
+		},
+		{
+			name: "Error: Upstream returns malformed JSON",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"jobs":`))
+			},
+			expectedErr:  ErrMalformedResponse,
+			expectedKind: apperr.UpstreamUnavailable,
+		},
+		{
+			name: "Error: Upstream does not respond within timeout",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-time.After(time.Second):
+				case <-r.Context().Done():
+				}
+			},
+			timeout:      50 * time.Millisecond,
+			expectedErr:  ErrTimeout,
+			expectedKind: apperr.UpstreamTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange: upstreamの代わりにhttptestサーバーを起動
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			client := New(&config.Config{ApiEndpoint: server.URL, ApiTimeout: 30})
+			if tt.timeout > 0 {
+				client.(*ClientImpl).HTTPClient.Timeout = tt.timeout
+			}
+
+			// Act
+			jobs, err := client.GetJobs(context.Background())
+
+			// Assert: エラーの検証
+			if tt.expectedKind != "" && apperr.KindOf(err) != tt.expectedKind {
+				t.Errorf("Expected error kind '%s', got '%s'", tt.expectedKind, apperr.KindOf(err))
+			}
+			if tt.expectedStatusCode != 0 {
+				var statusErr *StatusError
+				if !errors.As(err, &statusErr) {
+					t.Fatalf("Expected StatusError, got %v", err)
+				}
+				if statusErr.StatusCode != tt.expectedStatusCode {
+					t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, statusErr.StatusCode)
+				}
+				return
+			}
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Fatalf("Expected error '%v', got '%v'", tt.expectedErr, err)
+				}
+				if jobs != nil {
+					t.Errorf("Expected nil jobs, got %v", jobs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			// Assert: Jobsの検証
+			if len(jobs) != len(tt.expectedJobs) {
+				t.Fatalf("Expected %d jobs, got %d", len(tt.expectedJobs), len(jobs))
+			}
+			for i, expectedJob := range tt.expectedJobs {
+				if !reflect.DeepEqual(jobs[i], expectedJob) {
+					t.Errorf("Job[%d] mismatch:\n  expected: %+v\n  got:      %+v", i, expectedJob, jobs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestClientImpl_GetJobs_ContextCanceled(t *testing.T) {
+	// Arrange: リクエストを受けたらctxをキャンセルする
+	ctx, cancel := context.WithCancel(context.Background())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := New(&config.Config{ApiEndpoint: server.URL, ApiTimeout: 30})
+
+	// Act
+	_, err := client.GetJobs(ctx)
+
+	// Assert
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestClientImpl_GetJob(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		handler      http.HandlerFunc
+		expectedPath string
+		expectedJob  *model.Job
+		expectedKind apperr.Kind
+	}{
+		{
+			name: "Success: Job is decoded from upstream JSON",
+			id:   "42",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"id":"42","title":"SRE","company":"Tech Company","location":"Tokyo","description":"On-call"}`))
+			},
+			expectedPath: "/jobs/42",
+			expectedJob:  &model.Job{ID: "42", Title: "SRE", Company: "Tech Company", Location: "Tokyo", Description: "On-call"},
+		},
+		{
+			name: "Success: ID is escaped in the URL path",
+			id:   "a/b",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"id":"a/b","title":"SRE"}`))
+			},
+			expectedPath: "/jobs/a%2Fb",
+			expectedJob:  &model.Job{ID: "a/b", Title: "SRE"},
+		},
+		{
+			name: "Error: Upstream returns 404",
+			id:   "missing",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.NotFound(w, r)
+			},
+			expectedPath: "/jobs/missing",
+			expectedKind: apperr.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange: リクエストされたパスを記録するサーバー
+			var requestedPath string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.EscapedPath()
+				tt.handler(w, r)
+			}))
+			defer server.Close()
+
+			client := New(&config.Config{ApiEndpoint: server.URL + "/jobs", ApiTimeout: 30})
+
+			// Act
+			job, err := client.GetJob(context.Background(), tt.id)
+
+			// Assert
+			if requestedPath != tt.expectedPath {
+				t.Errorf("Expected path '%s', got '%s'", tt.expectedPath, requestedPath)
+			}
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				if job != nil {
+					t.Errorf("Expected nil job, got %+v", job)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(*job, *tt.expectedJob) {
+				t.Errorf("Job mismatch:\n  expected: %+v\n  got:      %+v", *tt.expectedJob, *job)
+			}
+		})
+	}
+}
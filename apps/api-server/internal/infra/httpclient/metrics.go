@@ -0,0 +1,52 @@
+package httpclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics"
+)
+
+// MetricsClient records the latency and failures of the requests to one upstream
+type MetricsClient struct {
+	name   string
+	client HttpClient
+	now    func() time.Time
+}
+
+// NewMetricsClient wraps client, labelling its metrics with the source name
+func NewMetricsClient(name string, client HttpClient) *MetricsClient {
+	return &MetricsClient{name: name, client: client, now: time.Now}
+}
+
+// Unwrap returns the wrapped client
+func (c *MetricsClient) Unwrap() HttpClient {
+	return c.client
+}
+
+// GetJobs fetches jobs and records the request
+func (c *MetricsClient) GetJobs(ctx context.Context) ([]model.Job, error) {
+	start := c.now()
+	jobs, err := c.client.GetJobs(ctx)
+	c.record(start, err)
+	return jobs, err
+}
+
+// GetJob fetches a single job and records the request
+func (c *MetricsClient) GetJob(ctx context.Context, id string) (*model.Job, error) {
+	start := c.now()
+	job, err := c.client.GetJob(ctx, id)
+	c.record(start, err)
+	return job, err
+}
+
+// record observes the latency of a request that started at start. A job that does not exist is an answer, not a failure.
+func (c *MetricsClient) record(start time.Time, err error) {
+	source := metrics.L("source", c.name)
+	metrics.Observe(metrics.UpstreamRequestDuration, float64(c.now().Sub(start))/float64(time.Millisecond), source)
+	if err != nil && !apperr.Is(err, apperr.NotFound) {
+		metrics.Add(metrics.UpstreamErrorsTotal, 1, source, metrics.L("kind", string(apperr.KindOf(err))))
+	}
+}
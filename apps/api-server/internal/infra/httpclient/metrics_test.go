@@ -0,0 +1,79 @@
+package httpclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	mock_httpclient "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient/mock"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics/metricstest"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMetricsClient(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(m *mock_httpclient.MockHttpClient)
+		expectedError apperr.Kind // kind label of the error counter; empty means no error is counted
+	}{
+		{
+			name: "Success: Only the latency is recorded",
+			mockSetup: func(m *mock_httpclient.MockHttpClient) {
+				m.EXPECT().GetJob(gomock.Any(), "1").Return(&model.Job{ID: "1"}, nil)
+			},
+		},
+		{
+			name: "Not found: Not counted as an error",
+			mockSetup: func(m *mock_httpclient.MockHttpClient) {
+				m.EXPECT().GetJob(gomock.Any(), "1").Return(nil, errNotFound)
+			},
+		},
+		{
+			name: "Failure: Counted with its kind",
+			mockSetup: func(m *mock_httpclient.MockHttpClient) {
+				m.EXPECT().GetJob(gomock.Any(), "1").Return(nil, errUnavailable)
+			},
+			expectedError: apperr.UpstreamUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			recorder := metricstest.Setup(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock_httpclient.NewMockHttpClient(ctrl)
+			tt.mockSetup(mockClient)
+
+			now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+			c := NewMetricsClient("feed", mockClient)
+			c.now = func() time.Time {
+				now = now.Add(250 * time.Millisecond)
+				return now
+			}
+
+			// Act
+			c.GetJob(context.Background(), "1")
+
+			// Assert
+			source := metrics.L("source", "feed")
+			if got := recorder.Samples(metrics.UpstreamRequestDuration, source); len(got) != 1 || got[0] != 250 {
+				t.Errorf("Expected a latency of 250ms, got %v", got)
+			}
+			for _, kind := range []apperr.Kind{apperr.UpstreamUnavailable, apperr.NotFound} {
+				expected := 0.0
+				if kind == tt.expectedError {
+					expected = 1
+				}
+				if got := recorder.Counter(metrics.UpstreamErrorsTotal, source, metrics.L("kind", string(kind))); got != expected {
+					t.Errorf("Expected %v %s errors, got %v", expected, kind, got)
+				}
+			}
+		})
+	}
+}
@@ -41,6 +41,21 @@ func (m *MockHttpClient) EXPECT() *MockHttpClientMockRecorder {
 	return m.recorder
 }
 
+// GetJob mocks base method.
+func (m *MockHttpClient) GetJob(ctx context.Context, id string) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, id)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockHttpClientMockRecorder) GetJob(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockHttpClient)(nil).GetJob), ctx, id)
+}
+
 // GetJobs mocks base method.
 func (m *MockHttpClient) GetJobs(ctx context.Context) ([]model.Job, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,209 @@
+package httpclient
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/config"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"go.uber.org/zap"
+)
+
+// RetryConfig controls how RetryTransport retries failed requests
+type RetryConfig struct {
+	MaxRetries     int           // retries after the first attempt; zero disables retrying
+	BaseDelay      time.Duration // backoff before the first retry, doubled for every further retry
+	MaxDelay       time.Duration // cap of the backoff and of an honored Retry-After
+	AttemptTimeout time.Duration // timeout of a single attempt; zero leaves attempts bounded only by the request ctx
+}
+
+// RetryTransport retries idempotent requests that failed with a network error, 429 or 5xx.
+// The delay between attempts is a capped exponential backoff with full jitter, or the upstream's Retry-After when it asks for longer.
+// The request ctx bounds all attempts together: no retry is made that could not start before its deadline.
+type RetryTransport struct {
+	base http.RoundTripper
+	cfg  RetryConfig
+
+	sleep  func(ctx context.Context, d time.Duration) error
+	jitter func(n int64) int64
+	now    func() time.Time
+}
+
+// NewRetryTransport wraps base, or http.DefaultTransport when base is nil
+func NewRetryTransport(base http.RoundTripper, cfg RetryConfig) *RetryTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RetryTransport{base: base, cfg: cfg, sleep: sleepCtx, jitter: rand.Int64N, now: time.Now}
+}
+
+// NewHTTPClient creates the *http.Client used for upstream requests, retrying as configured in cfg and forwarding the
+// trace ID and trace context of the request ctx. Every attempt is a client span of its own.
+// ApiTimeout bounds a request with all its retries, also when the caller's ctx has no deadline, as in the ingestion or
+// a background cache refresh; ApiAttemptTimeout bounds each attempt, so that a hanging attempt leaves time for a retry.
+func NewHTTPClient(cfg *config.Config) *http.Client {
+	return &http.Client{
+		Timeout: time.Duration(cfg.ApiTimeout) * time.Second,
+		Transport: NewTraceTransport(NewRetryTransport(newOtelTransport(), RetryConfig{
+			MaxRetries:     cfg.ApiMaxRetries,
+			BaseDelay:      time.Duration(cfg.ApiRetryBaseDelay) * time.Millisecond,
+			MaxDelay:       time.Duration(cfg.ApiRetryMaxDelay) * time.Millisecond,
+			AttemptTimeout: time.Duration(cfg.ApiAttemptTimeout) * time.Second,
+		})),
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	retryable := isIdempotent(req) && (req.Body == nil || req.Body == http.NoBody || req.GetBody != nil)
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.attempt(req, attempt)
+		if !retryable || attempt >= t.cfg.MaxRetries || ctx.Err() != nil || !shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		delay := t.backoff(attempt)
+		if resp != nil {
+			if after, ok := t.retryAfter(resp); ok {
+				if after > t.cfg.MaxDelay {
+					// The upstream asked to come back later than we are willing to wait
+					return resp, nil
+				}
+				delay = max(delay, after)
+			}
+		}
+		if deadline, ok := ctx.Deadline(); ok && t.now().Add(delay).After(deadline) {
+			return resp, err
+		}
+
+		fields := []zap.Field{zap.String("method", req.Method), zap.String("url", req.URL.Redacted()), zap.Int("attempt", attempt+1), zap.Duration("delay", delay)}
+		if err != nil {
+			fields = append(fields, zap.Error(err))
+		} else {
+			fields = append(fields, zap.Int("status", resp.StatusCode))
+			drain(resp)
+		}
+		logger.Warn(ctx, "Retrying upstream request", fields...)
+
+		if err := t.sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// attempt sends one try of req. With an AttemptTimeout, the attempt's ctx lives until the response body is closed.
+func (t *RetryTransport) attempt(req *http.Request, n int) (*http.Response, error) {
+	ctx := req.Context()
+	cancel := context.CancelFunc(func() {})
+	if t.cfg.AttemptTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t.cfg.AttemptTimeout)
+	}
+
+	try := req.Clone(ctx)
+	if n > 0 && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		try.Body = body
+	}
+
+	resp, err := t.base.RoundTrip(try)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// backoff returns the full-jitter delay before retry number attempt+1: a random duration up to BaseDelay*2^attempt, capped at MaxDelay
+func (t *RetryTransport) backoff(attempt int) time.Duration {
+	ceiling := t.cfg.MaxDelay
+	if attempt < 62 && t.cfg.BaseDelay < ceiling>>attempt {
+		ceiling = t.cfg.BaseDelay << attempt
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(t.jitter(int64(ceiling) + 1))
+}
+
+// retryAfter parses the Retry-After header of a 429 or 503 response, given in seconds or as an HTTP date
+func (t *RetryTransport) retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(t.now()), 0), true
+	}
+	return 0, false
+}
+
+// shouldRetry reports whether a failed attempt may succeed when repeated
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode == http.StatusNotImplemented || resp.StatusCode == http.StatusHTTPVersionNotSupported:
+		return false
+	default:
+		return resp.StatusCode >= 500
+	}
+}
+
+// isIdempotent reports whether req can be sent more than once without changing its effect
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != ""
+}
+
+// drain discards the rest of a response that is not going to be used, so that its connection can be reused
+func drain(resp *http.Response) {
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorBodySize))
+	resp.Body.Close()
+}
+
+// sleepCtx waits for d, or returns the ctx error when ctx is done first
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// cancelOnClose releases the ctx of an attempt once its response body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
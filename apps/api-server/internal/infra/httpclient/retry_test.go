@@ -0,0 +1,289 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/config"
+)
+
+// newTestRetryTransport creates a RetryTransport that records its delays instead of sleeping and always waits the full backoff
+func newTestRetryTransport(cfg RetryConfig) (*RetryTransport, *[]time.Duration) {
+	var delays []time.Duration
+	rt := NewRetryTransport(nil, cfg)
+	rt.sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return ctx.Err()
+	}
+	rt.jitter = func(n int64) int64 { return n - 1 }
+	return rt, &delays
+}
+
+func TestRetryTransport_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		responses        []int
+		retryAfter       string
+		expectedStatus   int
+		expectedAttempts int
+		expectedDelays   []time.Duration
+	}{
+		{
+			name:             "Success: 503 is retried",
+			method:           http.MethodGet,
+			responses:        []int{503, 200},
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 2,
+			expectedDelays:   []time.Duration{100 * time.Millisecond},
+		},
+		{
+			name:             "Success: Backoff doubles up to the cap",
+			method:           http.MethodGet,
+			responses:        []int{500, 502, 504, 200},
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 4,
+			expectedDelays:   []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond},
+		},
+		{
+			name:             "Success: 429 is retried",
+			method:           http.MethodGet,
+			responses:        []int{429, 200},
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 2,
+			expectedDelays:   []time.Duration{100 * time.Millisecond},
+		},
+		{
+			name:             "Error: Attempts stop after MaxRetries",
+			method:           http.MethodGet,
+			responses:        []int{500, 500, 500, 500, 500},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedAttempts: 4,
+			expectedDelays:   []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond},
+		},
+		{
+			name:             "Error: Retry-After longer than MaxDelay is not waited for",
+			method:           http.MethodGet,
+			responses:        []int{429, 200},
+			retryAfter:       "60",
+			expectedStatus:   http.StatusTooManyRequests,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "Error: 404 is not retried",
+			method:           http.MethodGet,
+			responses:        []int{404, 200},
+			expectedStatus:   http.StatusNotFound,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "Error: 501 is not retried",
+			method:           http.MethodGet,
+			responses:        []int{501, 200},
+			expectedStatus:   http.StatusNotImplemented,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "Error: POST is not retried",
+			method:           http.MethodPost,
+			responses:        []int{503, 200},
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange: responses の順にステータスを返すサーバー
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(attempts.Add(1)) - 1
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.responses[min(n, len(tt.responses)-1)])
+			}))
+			defer server.Close()
+
+			rt, delays := newTestRetryTransport(RetryConfig{MaxRetries: 3, BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond})
+			req, _ := http.NewRequestWithContext(context.Background(), tt.method, server.URL, nil)
+
+			// Act
+			resp, err := rt.RoundTrip(req)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if int(attempts.Load()) != tt.expectedAttempts {
+				t.Errorf("Expected %d attempts, got %d", tt.expectedAttempts, attempts.Load())
+			}
+			if !slices.Equal(*delays, tt.expectedDelays) {
+				t.Errorf("Expected delays %v, got %v", tt.expectedDelays, *delays)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_RoundTrip_RetryAfterSeconds(t *testing.T) {
+	// Arrange: Retry-After がバックオフより長い
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", strconv.Itoa(2))
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rt, delays := newTestRetryTransport(RetryConfig{MaxRetries: 1, BaseDelay: 100 * time.Millisecond, MaxDelay: 5 * time.Second})
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+
+	// Act
+	resp, err := rt.RoundTrip(req)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if !slices.Equal(*delays, []time.Duration{2 * time.Second}) {
+		t.Errorf("Expected the Retry-After delay, got %v", *delays)
+	}
+}
+
+func TestRetryTransport_RoundTrip_NetworkError(t *testing.T) {
+	// Arrange: 1回目は接続を切断する
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rt, _ := newTestRetryTransport(RetryConfig{MaxRetries: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond})
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+
+	// Act
+	resp, err := rt.RoundTrip(req)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || attempts.Load() != 2 {
+		t.Errorf("Expected 200 after 2 attempts, got %d after %d", resp.StatusCode, attempts.Load())
+	}
+}
+
+func TestRetryTransport_RoundTrip_AttemptTimeout(t *testing.T) {
+	// Arrange: 1回目は応答しない
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			<-r.Context().Done()
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	rt, _ := newTestRetryTransport(RetryConfig{MaxRetries: 1, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, AttemptTimeout: 50 * time.Millisecond})
+	client := &http.Client{Transport: rt}
+
+	// Act
+	resp, err := client.Get(server.URL)
+
+	// Assert: 2回目のレスポンスは1回目のタイムアウトの影響を受けずに読める
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer resp.Body.Close()
+	var body [2]byte
+	if n, _ := resp.Body.Read(body[:]); string(body[:n]) != "ok" {
+		t.Errorf("Expected body 'ok', got %q", body[:n])
+	}
+	if attempts.Load() != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts.Load())
+	}
+}
+
+func TestRetryTransport_RoundTrip_ContextDeadline(t *testing.T) {
+	// Arrange: バックオフがctxの期限を超える
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	rt, delays := newTestRetryTransport(RetryConfig{MaxRetries: 3, BaseDelay: time.Second, MaxDelay: time.Second})
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+	// Act
+	resp, err := rt.RoundTrip(req)
+
+	// Assert: 待たずに最後のレスポンスを返す
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || attempts.Load() != 1 || len(*delays) != 0 {
+		t.Errorf("Expected a single attempt returning 503, got %d after %d attempts and delays %v", resp.StatusCode, attempts.Load(), *delays)
+	}
+}
+
+func TestNewHTTPClient_Timeouts(t *testing.T) {
+	// Arrange: 2回目の試行は1回ごとのタイムアウト内に応答するが、全体の期限を超える
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		select {
+		case <-time.After(2 * time.Second):
+		case <-r.Context().Done():
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(&config.Config{ApiTimeout: 1, ApiAttemptTimeout: 3, ApiMaxRetries: 2, ApiRetryBaseDelay: 1, ApiRetryMaxDelay: 10})
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+
+	// Act
+	start := time.Now()
+	resp, err := client.Do(req)
+
+	// Assert: ctx に期限がなくても ApiTimeout で打ち切られる
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("Expected a timeout error, got none")
+	}
+	if elapsed := time.Since(start); elapsed > 1500*time.Millisecond {
+		t.Errorf("Expected the request to give up after about 1s, took %v", elapsed)
+	}
+	if attempts.Load() != 1 {
+		t.Errorf("Expected no retry after the overall deadline, got %d attempts", attempts.Load())
+	}
+}
@@ -0,0 +1,44 @@
+package httpclient
+
+import (
+	"net/http"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// TraceTransport forwards the trace ID of the request ctx to the upstream in the X-Request-Id header, so that the
+// upstream's logs can be joined with ours
+type TraceTransport struct {
+	base http.RoundTripper
+}
+
+// NewTraceTransport wraps base, or http.DefaultTransport when base is nil
+func NewTraceTransport(base http.RoundTripper) *TraceTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &TraceTransport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper. A trace ID the caller already set is kept.
+func (t *TraceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	traceID := logger.TraceIDFrom(req.Context())
+	if traceID == "" || req.Header.Get(logger.TraceIDHeader) != "" {
+		return t.base.RoundTrip(req)
+	}
+	// A RoundTripper must not modify the caller's request
+	req = req.Clone(req.Context())
+	req.Header.Set(logger.TraceIDHeader, traceID)
+	return t.base.RoundTrip(req)
+}
+
+// newOtelTransport creates a transport that records every request as a client span named after the method and the
+// upstream host, and sends the W3C traceparent header
+func newOtelTransport() http.RoundTripper {
+	return otelhttp.NewTransport(http.DefaultTransport,
+		otelhttp.WithSpanNameFormatter(func(_ string, req *http.Request) string {
+			return req.Method + " " + req.URL.Host
+		}),
+	)
+}
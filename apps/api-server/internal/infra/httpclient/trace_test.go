@@ -0,0 +1,134 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/config"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/tracing/tracingtest"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceTransport_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name            string
+		traceID         string
+		header          string
+		expectedTraceID string
+	}{
+		{
+			name:            "Trace ID of the ctx is forwarded",
+			traceID:         "4f1c2a9e8b7d6c5e",
+			expectedTraceID: "4f1c2a9e8b7d6c5e",
+		},
+		{
+			name:            "Header set by the caller is kept",
+			traceID:         "4f1c2a9e8b7d6c5e",
+			header:          "caller-id",
+			expectedTraceID: "caller-id",
+		},
+		{
+			name: "No trace ID: Header is not sent",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var received string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r.Header.Get("X-Request-Id")
+			}))
+			defer server.Close()
+
+			ctx := context.Background()
+			if tt.traceID != "" {
+				ctx = logger.WithTraceID(ctx, tt.traceID)
+			}
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+			if tt.header != "" {
+				req.Header.Set("X-Request-Id", tt.header)
+			}
+			client := &http.Client{Transport: NewTraceTransport(nil)}
+
+			// Act
+			resp, err := client.Do(req)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			resp.Body.Close()
+			if received != tt.expectedTraceID {
+				t.Errorf("Expected X-Request-Id '%s', got '%s'", tt.expectedTraceID, received)
+			}
+			if tt.header == "" && req.Header.Get("X-Request-Id") != "" {
+				t.Error("Expected the caller's request to be left unmodified")
+			}
+		})
+	}
+}
+
+func TestNewHTTPClient_Spans(t *testing.T) {
+	// Arrange: 1回目は 503、リトライで成功する
+	exporter := tracingtest.Setup(t)
+	var attempts atomic.Int32
+	var traceparents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents = append(traceparents, r.Header.Get("traceparent"))
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(&config.Config{ApiTimeout: 5, ApiMaxRetries: 1, ApiRetryBaseDelay: 1, ApiRetryMaxDelay: 10})
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "ServiceImpl.FetchJobs")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/jobs", nil)
+
+	// Act
+	resp, err := client.Do(req)
+	parent.End()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	var clientSpans []string
+	for _, span := range exporter.GetSpans() {
+		if span.SpanKind != trace.SpanKindClient {
+			continue
+		}
+		clientSpans = append(clientSpans, span.SpanContext.SpanID().String())
+		if span.Name != "GET "+strings.TrimPrefix(server.URL, "http://") {
+			t.Errorf("Expected the span to be named after the method and host, got '%s'", span.Name)
+		}
+		if span.Parent.SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("Expected the client span to be a child of the caller's span")
+		}
+	}
+	if len(clientSpans) != 2 {
+		t.Fatalf("Expected a client span per attempt, got %d", len(clientSpans))
+	}
+	failed := tracingtest.Find(exporter.GetSpans(), "GET "+strings.TrimPrefix(server.URL, "http://"))
+	if failed.Status.Code != codes.Error {
+		t.Errorf("Expected the 503 attempt to be marked as failed, got %v", failed.Status)
+	}
+	// traceparent はリクエストごとに自分のクライアントスパンを親として送られる
+	for i, traceparent := range traceparents {
+		if !strings.Contains(traceparent, parent.SpanContext().TraceID().String()) || !strings.Contains(traceparent, clientSpans[i]) {
+			t.Errorf("Attempt %d: expected traceparent of client span %s, got '%s'", i, clientSpans[i], traceparent)
+		}
+	}
+}
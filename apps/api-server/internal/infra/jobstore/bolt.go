@@ -0,0 +1,213 @@
+package jobstore
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/repository"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// jobsBucket maps a job ID to the JSON-encoded job
+	jobsBucket = []byte("jobs")
+	// updatedBucket indexes jobs by UpdatedAt; keys are the 8-byte big-endian UnixNano followed by the job ID
+	updatedBucket = []byte("jobs_by_updated_at")
+)
+
+// BoltStore is a JobRepository persisted to a single bbolt file, so it needs no external service
+type BoltStore struct {
+	db  *bolt.DB
+	now func() time.Time
+}
+
+// OpenBolt opens or creates the bbolt database at path
+func OpenBolt(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, apperr.Wrap(apperr.Internal, err, "failed to open job store")
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{jobsBucket, updatedBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, apperr.Wrap(apperr.Internal, err, "failed to initialize job store")
+	}
+	return &BoltStore{db: db, now: time.Now}, nil
+}
+
+// Close releases the database file
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+// Upsert stores job by ID
+func (s *BoltStore) Upsert(ctx context.Context, job model.Job) (repository.UpsertResult, error) {
+	if err := validateID(job.ID); err != nil {
+		return "", err
+	}
+
+	result := repository.Created
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		jobs, index := tx.Bucket(jobsBucket), tx.Bucket(updatedBucket)
+
+		if raw := jobs.Get([]byte(job.ID)); raw != nil {
+			var stored model.Job
+			if err := json.Unmarshal(raw, &stored); err != nil {
+				return err
+			}
+			same, err := sameContent(stored, job)
+			if err != nil {
+				return err
+			}
+			if same {
+				result = repository.Unchanged
+				return nil
+			}
+			result = repository.Updated
+			if err := index.Delete(updatedKey(stored.UpdatedAt, stored.ID)); err != nil {
+				return err
+			}
+		}
+
+		job.UpdatedAt = s.now().UTC()
+		raw, err := json.Marshal(job)
+		if err != nil {
+			return err
+		}
+		if err := jobs.Put([]byte(job.ID), raw); err != nil {
+			return err
+		}
+		return index.Put(updatedKey(job.UpdatedAt, job.ID), nil)
+	})
+	if err != nil {
+		return "", apperr.Wrap(apperr.Internal, err, "failed to store job")
+	}
+	return result, nil
+}
+
+// Get returns the job with the given ID
+func (s *BoltStore) Get(ctx context.Context, id string) (*model.Job, error) {
+	var job *model.Job
+	err := s.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(jobsBucket).Get([]byte(id))
+		if raw == nil {
+			return nil
+		}
+		job = &model.Job{}
+		return json.Unmarshal(raw, job)
+	})
+	if err != nil {
+		return nil, apperr.Wrap(apperr.Internal, err, "failed to read job")
+	}
+	if job == nil {
+		return nil, notFound(id)
+	}
+	return job, nil
+}
+
+// Query returns every job matching query's filters, ordered by ID
+func (s *BoltStore) Query(ctx context.Context, query model.JobQuery) ([]model.Job, error) {
+	jobs := []model.Job{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(jobsBucket).ForEach(func(_, raw []byte) error {
+			var job model.Job
+			if err := json.Unmarshal(raw, &job); err != nil {
+				return err
+			}
+			if query.Matches(job) {
+				jobs = append(jobs, job)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, apperr.Wrap(apperr.Internal, err, "failed to query jobs")
+	}
+	return jobs, nil
+}
+
+// Delete removes the job with the given ID
+func (s *BoltStore) Delete(ctx context.Context, id string) error {
+	found := false
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		jobs := tx.Bucket(jobsBucket)
+		raw := jobs.Get([]byte(id))
+		if raw == nil {
+			return nil
+		}
+		found = true
+
+		var stored model.Job
+		if err := json.Unmarshal(raw, &stored); err != nil {
+			return err
+		}
+		if err := tx.Bucket(updatedBucket).Delete(updatedKey(stored.UpdatedAt, id)); err != nil {
+			return err
+		}
+		return jobs.Delete([]byte(id))
+	})
+	if err != nil {
+		return apperr.Wrap(apperr.Internal, err, "failed to delete job")
+	}
+	if !found {
+		return notFound(id)
+	}
+	return nil
+}
+
+// ListUpdatedSince returns the jobs updated after since, oldest first
+func (s *BoltStore) ListUpdatedSince(ctx context.Context, since time.Time) ([]model.Job, error) {
+	var jobs []model.Job
+	err := s.db.View(func(tx *bolt.Tx) error {
+		byID := tx.Bucket(jobsBucket)
+		c := tx.Bucket(updatedBucket).Cursor()
+		// Keys sort by time first, so seeking past since skips every older job
+		for k, _ := c.Seek(updatedKey(since.Add(time.Nanosecond), "")); k != nil; k, _ = c.Next() {
+			var job model.Job
+			if err := json.Unmarshal(byID.Get(k[8:]), &job); err != nil {
+				return err
+			}
+			jobs = append(jobs, job)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, apperr.Wrap(apperr.Internal, err, "failed to list updated jobs")
+	}
+	return jobs, nil
+}
+
+// Ping checks that the database file can still be read
+func (s *BoltStore) Ping(ctx context.Context) error {
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(jobsBucket) == nil {
+			return errors.New("jobs bucket is missing")
+		}
+		return nil
+	})
+	if err != nil {
+		return apperr.Wrap(apperr.Internal, err, "job store is unavailable")
+	}
+	return nil
+}
+
+// updatedKey builds the updatedBucket key of a job. Times before 1970, such as the zero time, sort first.
+func updatedKey(updatedAt time.Time, id string) []byte {
+	key := make([]byte, 8, 8+len(id))
+	if nanos := updatedAt.UnixNano(); updatedAt.After(time.Unix(0, 0)) {
+		binary.BigEndian.PutUint64(key, uint64(nanos))
+	}
+	return append(key, id...)
+}
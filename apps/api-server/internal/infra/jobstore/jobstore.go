@@ -0,0 +1,38 @@
+package jobstore
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+// sameContent reports whether a and b differ only in UpdatedAt
+func sameContent(a, b model.Job) (bool, error) {
+	a.UpdatedAt, b.UpdatedAt = time.Time{}, time.Time{}
+	encodedA, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	encodedB, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(encodedA, encodedB), nil
+}
+
+// validateID rejects IDs that cannot be stored
+func validateID(id string) error {
+	if strings.TrimSpace(id) == "" {
+		return apperr.New(apperr.InvalidArgument, "job id must not be empty")
+	}
+	return nil
+}
+
+func notFound(id string) error {
+	return apperr.New(apperr.NotFound, fmt.Sprintf("job %q not found", id))
+}
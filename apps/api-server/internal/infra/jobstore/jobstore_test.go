@@ -0,0 +1,348 @@
+package jobstore
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/repository"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+// fakeClock returns a time one second later on every call
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(time.Second)
+	return c.now
+}
+
+// stores runs fn against every JobRepository implementation, each driven by a fake clock
+func stores(t *testing.T, fn func(t *testing.T, store repository.JobRepository)) {
+	t.Run("memory", func(t *testing.T) {
+		store := NewMemory()
+		store.now = (&fakeClock{now: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)}).Now
+		fn(t, store)
+	})
+	t.Run("bolt", func(t *testing.T) {
+		store, err := OpenBolt(filepath.Join(t.TempDir(), "jobs.db"))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		defer store.Close()
+		store.now = (&fakeClock{now: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)}).Now
+		fn(t, store)
+	})
+}
+
+func TestStore_Upsert(t *testing.T) {
+	stores(t, func(t *testing.T, store repository.JobRepository) {
+		ctx := context.Background()
+		job := model.Job{ID: "1", Title: "Backend Engineer", TechStack: []string{"go"}}
+
+		// Act & Assert: 新規作成
+		result, err := store.Upsert(ctx, job)
+		if err != nil || result != repository.Created {
+			t.Fatalf("Expected created, got %v (%v)", result, err)
+		}
+		created, _ := store.Get(ctx, "1")
+
+		// Act & Assert: 同じ内容なら更新されない
+		result, err = store.Upsert(ctx, job)
+		if err != nil || result != repository.Unchanged {
+			t.Fatalf("Expected unchanged, got %v (%v)", result, err)
+		}
+		unchanged, _ := store.Get(ctx, "1")
+		if !unchanged.UpdatedAt.Equal(created.UpdatedAt) {
+			t.Errorf("Expected UpdatedAt to stay %v, got %v", created.UpdatedAt, unchanged.UpdatedAt)
+		}
+
+		// Act & Assert: 内容が変われば更新され、UpdatedAtが進む
+		job.Title = "Senior Backend Engineer"
+		result, err = store.Upsert(ctx, job)
+		if err != nil || result != repository.Updated {
+			t.Fatalf("Expected updated, got %v (%v)", result, err)
+		}
+		updated, _ := store.Get(ctx, "1")
+		if updated.Title != "Senior Backend Engineer" || !updated.UpdatedAt.After(created.UpdatedAt) {
+			t.Errorf("Expected updated job with later UpdatedAt, got %+v", updated)
+		}
+	})
+}
+
+func TestStore_Upsert_EmptyID(t *testing.T) {
+	stores(t, func(t *testing.T, store repository.JobRepository) {
+		// Act
+		_, err := store.Upsert(context.Background(), model.Job{Title: "No ID"})
+
+		// Assert
+		if apperr.KindOf(err) != apperr.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+}
+
+func TestStore_Get(t *testing.T) {
+	stores(t, func(t *testing.T, store repository.JobRepository) {
+		// Arrange
+		ctx := context.Background()
+		visa := true
+		job := model.Job{
+			ID:              "1",
+			Title:           "Backend Engineer",
+			Salary:          &model.Salary{Min: 6000000, Max: 9000000, Currency: "JPY", Period: model.SalaryYearly},
+			Place:           &model.Place{PrefectureCode: "13"},
+			VisaSponsorship: &visa,
+			TechStack:       []string{"go", "aws"},
+		}
+		store.Upsert(ctx, job)
+
+		// Act
+		got, err := store.Get(ctx, "1")
+
+		// Assert: UpdatedAt以外は保存した内容と一致する
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if got.UpdatedAt.IsZero() {
+			t.Error("Expected UpdatedAt to be set")
+		}
+		got.UpdatedAt = time.Time{}
+		if !reflect.DeepEqual(*got, job) {
+			t.Errorf("Job mismatch:\n  expected: %+v\n  got:      %+v", job, *got)
+		}
+
+		// Assert: 返却されたJobを変更しても保存内容には影響しない
+		got.TechStack[0] = "rust"
+		again, _ := store.Get(ctx, "1")
+		if again.TechStack[0] != "go" {
+			t.Errorf("Expected stored tech stack to be unchanged, got %v", again.TechStack)
+		}
+
+		// Act & Assert: 存在しないID
+		if _, err := store.Get(ctx, "missing"); apperr.KindOf(err) != apperr.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+	})
+}
+
+func TestStore_Query(t *testing.T) {
+	stores(t, func(t *testing.T, store repository.JobRepository) {
+		// Arrange
+		ctx := context.Background()
+		for _, job := range []model.Job{
+			{ID: "c", Title: "Go Engineer", Location: "Tokyo"},
+			{ID: "a", Title: "Go Developer", Location: "Osaka"},
+			{ID: "b", Title: "Frontend Engineer", Location: "Tokyo"},
+		} {
+			store.Upsert(ctx, job)
+		}
+
+		tests := []struct {
+			name        string
+			query       model.JobQuery
+			expectedIDs []string
+		}{
+			{name: "Zero query returns every job ordered by ID", query: model.JobQuery{}, expectedIDs: []string{"a", "b", "c"}},
+			{name: "Filters are applied", query: model.JobQuery{Keyword: "go", Location: "tokyo"}, expectedIDs: []string{"c"}},
+			{name: "No match", query: model.JobQuery{Company: "Unknown"}, expectedIDs: []string{}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				// Act
+				jobs, err := store.Query(ctx, tt.query)
+
+				// Assert
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				assertIDs(t, jobs, tt.expectedIDs...)
+			})
+		}
+	})
+}
+
+func TestStore_Delete(t *testing.T) {
+	stores(t, func(t *testing.T, store repository.JobRepository) {
+		// Arrange
+		ctx := context.Background()
+		store.Upsert(ctx, model.Job{ID: "1", Title: "Backend Engineer"})
+
+		// Act
+		err := store.Delete(ctx, "1")
+
+		// Assert
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if _, err := store.Get(ctx, "1"); apperr.KindOf(err) != apperr.NotFound {
+			t.Errorf("Expected deleted job to be NotFound, got %v", err)
+		}
+		if updated, _ := store.ListUpdatedSince(ctx, time.Time{}); len(updated) != 0 {
+			t.Errorf("Expected deleted job to leave the updated index, got %+v", updated)
+		}
+		if err := store.Delete(ctx, "1"); apperr.KindOf(err) != apperr.NotFound {
+			t.Errorf("Expected NotFound on second delete, got %v", err)
+		}
+	})
+}
+
+func TestStore_ListUpdatedSince(t *testing.T) {
+	stores(t, func(t *testing.T, store repository.JobRepository) {
+		// Arrange: a, b, c の順に作成し、その後 a を更新する
+		ctx := context.Background()
+		for _, id := range []string{"a", "b", "c"} {
+			store.Upsert(ctx, model.Job{ID: id, Title: "Engineer"})
+		}
+		b, _ := store.Get(ctx, "b")
+		store.Upsert(ctx, model.Job{ID: "a", Title: "Senior Engineer"})
+
+		// Act
+		all, err := store.ListUpdatedSince(ctx, time.Time{})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		afterB, err := store.ListUpdatedSince(ctx, b.UpdatedAt)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		// Assert: 更新が古い順に並び、sinceちょうどのJobは含まない
+		assertIDs(t, all, "b", "c", "a")
+		assertIDs(t, afterB, "c", "a")
+	})
+}
+
+func TestStore_ConcurrentUpserts(t *testing.T) {
+	stores(t, func(t *testing.T, store repository.JobRepository) {
+		// Arrange
+		ctx := context.Background()
+		var wg sync.WaitGroup
+
+		// Act: 複数のgoroutineから同時に書き込む
+		for i := range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				store.Upsert(ctx, model.Job{ID: fmt.Sprintf("job-%02d", i), Title: "Engineer"})
+				store.Query(ctx, model.JobQuery{})
+			}()
+		}
+		wg.Wait()
+
+		// Assert
+		jobs, err := store.Query(ctx, model.JobQuery{})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(jobs) != 20 {
+			t.Errorf("Expected 20 jobs, got %d", len(jobs))
+		}
+	})
+}
+
+func TestBoltStore_PersistsAcrossReopen(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "jobs.db")
+	store, err := OpenBolt(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	store.Upsert(context.Background(), model.Job{ID: "1", Title: "Backend Engineer"})
+	store.Close()
+
+	// Act
+	reopened, err := OpenBolt(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer reopened.Close()
+	job, err := reopened.Get(context.Background(), "1")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if job.Title != "Backend Engineer" {
+		t.Errorf("Expected persisted job, got %+v", job)
+	}
+}
+
+func TestBoltStore_OpenWhileHeld(t *testing.T) {
+	// Arrange: 同じファイルを開いたままにする
+	path := filepath.Join(t.TempDir(), "jobs.db")
+	store, err := OpenBolt(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Act
+	_, heldErr := OpenBolt(path)
+	store.Close()
+	reopened, err := OpenBolt(path)
+
+	// Assert: ロック中は失敗し、閉じた後は開ける
+	if apperr.KindOf(heldErr) != apperr.Internal {
+		t.Errorf("Expected an internal error while the file is held, got %v", heldErr)
+	}
+	if err != nil {
+		t.Fatalf("Expected the file to open once released, got %v", err)
+	}
+	reopened.Close()
+}
+
+func assertIDs(t *testing.T, jobs []model.Job, expected ...string) {
+	t.Helper()
+	ids := make([]string, len(jobs))
+	for i, job := range jobs {
+		ids[i] = job.ID
+	}
+	if len(ids) != len(expected) {
+		t.Fatalf("Expected IDs %v, got %v", expected, ids)
+	}
+	for i := range expected {
+		if ids[i] != expected[i] {
+			t.Fatalf("Expected IDs %v, got %v", expected, ids)
+		}
+	}
+}
+
+func TestStore_Ping(t *testing.T) {
+	stores(t, func(t *testing.T, store repository.JobRepository) {
+		// Act
+		err := store.Ping(context.Background())
+
+		// Assert
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+}
+
+func TestBoltStore_Ping_Closed(t *testing.T) {
+	// Arrange
+	store, err := OpenBolt(filepath.Join(t.TempDir(), "jobs.db"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	store.Close()
+
+	// Act
+	err = store.Ping(context.Background())
+
+	// Assert
+	if apperr.KindOf(err) != apperr.Internal {
+		t.Errorf("Expected an internal error, got %v", err)
+	}
+}
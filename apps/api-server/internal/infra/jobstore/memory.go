@@ -0,0 +1,120 @@
+package jobstore
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/repository"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+// MemoryStore is a JobRepository that keeps jobs in memory.
+// On Lambda the jobs live as long as the execution environment.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	jobs map[string]model.Job
+	now  func() time.Time
+}
+
+// NewMemory creates a new empty MemoryStore
+func NewMemory() *MemoryStore {
+	return &MemoryStore{
+		jobs: make(map[string]model.Job),
+		now:  time.Now,
+	}
+}
+
+// Upsert stores job by ID
+func (s *MemoryStore) Upsert(ctx context.Context, job model.Job) (repository.UpsertResult, error) {
+	if err := validateID(job.ID); err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := repository.Created
+	if stored, ok := s.jobs[job.ID]; ok {
+		same, err := sameContent(stored, job)
+		if err != nil {
+			return "", apperr.Wrap(apperr.Internal, err, "failed to compare jobs")
+		}
+		if same {
+			return repository.Unchanged, nil
+		}
+		result = repository.Updated
+	}
+
+	job = job.Clone()
+	job.UpdatedAt = s.now().UTC()
+	s.jobs[job.ID] = job
+	return result, nil
+}
+
+// Get returns the job with the given ID
+func (s *MemoryStore) Get(ctx context.Context, id string) (*model.Job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, notFound(id)
+	}
+	job = job.Clone()
+	return &job, nil
+}
+
+// Query returns every job matching query's filters, ordered by ID
+func (s *MemoryStore) Query(ctx context.Context, query model.JobQuery) ([]model.Job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	jobs := make([]model.Job, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		if query.Matches(job) {
+			jobs = append(jobs, job.Clone())
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+	return jobs, nil
+}
+
+// Delete removes the job with the given ID
+func (s *MemoryStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.jobs[id]; !ok {
+		return notFound(id)
+	}
+	delete(s.jobs, id)
+	return nil
+}
+
+// Ping implements repository.JobRepository. An in-memory store is always available.
+func (s *MemoryStore) Ping(ctx context.Context) error {
+	return nil
+}
+
+// ListUpdatedSince returns the jobs updated after since, oldest first
+func (s *MemoryStore) ListUpdatedSince(ctx context.Context, since time.Time) ([]model.Job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var jobs []model.Job
+	for _, job := range s.jobs {
+		if job.UpdatedAt.After(since) {
+			jobs = append(jobs, job.Clone())
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		if !jobs[i].UpdatedAt.Equal(jobs[j].UpdatedAt) {
+			return jobs[i].UpdatedAt.Before(jobs[j].UpdatedAt)
+		}
+		return jobs[i].ID < jobs[j].ID
+	})
+	return jobs, nil
+}
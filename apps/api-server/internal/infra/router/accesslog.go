@@ -0,0 +1,136 @@
+package router
+
+import (
+	"maps"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/awslabs/aws-lambda-go-api-proxy/core"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// redacted replaces the values of redacted query parameters
+const redacted = "REDACTED"
+
+// AccessLogConfig controls the access log
+type AccessLogConfig struct {
+	// SampleRate is the fraction of requests answered below 400 that are logged; errors are always logged
+	SampleRate float64
+	// Headers names the request headers that are logged, case-insensitively. Any other header is left out, so that
+	// credentials in headers nobody thought of are never logged.
+	Headers []string
+	// RedactQueryParams names the query parameters whose values are not logged, case-insensitively
+	RedactQueryParams []string
+}
+
+// accessLog writes one structured log entry per request
+type accessLog struct {
+	sampleRate        float64
+	headers           map[string]bool // lower-case names
+	redactQueryParams map[string]bool // lower-case names
+}
+
+// newAccessLog creates the access log of cfg
+func newAccessLog(cfg AccessLogConfig) *accessLog {
+	return &accessLog{
+		sampleRate:        cfg.SampleRate,
+		headers:           lowerSet(cfg.Headers),
+		redactQueryParams: lowerSet(cfg.RedactQueryParams),
+	}
+}
+
+// lowerSet returns the set of names in lower case
+func lowerSet(names []string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+	return set
+}
+
+// middleware logs every request once it has been answered, with the route it matched and the trace ID of its logs
+func (a *accessLog) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, req.ProtoMajor)
+		next.ServeHTTP(ww, req)
+		latency := time.Since(start)
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		if status < http.StatusBadRequest && rand.Float64() >= a.sampleRate {
+			return
+		}
+
+		route := chi.RouteContext(req.Context()).RoutePattern()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		fields := []zap.Field{
+			zap.String("method", req.Method),
+			zap.String("route", route),
+			zap.String("path", req.URL.Path),
+			zap.String("query", a.query(req)),
+			zap.Int("status", status),
+			zap.Int("bytes", ww.BytesWritten()),
+			zap.Duration("latency", latency),
+			zap.String("user_agent", req.UserAgent()),
+			zap.String("client_ip", clientIP(req)),
+			zap.Object("headers", a.loggedHeaders(req)),
+		}
+		if status >= http.StatusInternalServerError {
+			logger.Warn(req.Context(), "Request handled", fields...)
+			return
+		}
+		logger.Info(req.Context(), "Request handled", fields...)
+	})
+}
+
+// query returns the query string of req with the values of redacted parameters replaced
+func (a *accessLog) query(req *http.Request) string {
+	if req.URL.RawQuery == "" {
+		return ""
+	}
+	values := req.URL.Query()
+	for name := range values {
+		if a.redactQueryParams[strings.ToLower(name)] {
+			values[name] = []string{redacted}
+		}
+	}
+	return values.Encode()
+}
+
+// loggedHeaders returns the request headers of req that are configured to be logged
+func (a *accessLog) loggedHeaders(req *http.Request) zapcore.ObjectMarshaler {
+	return zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for _, name := range slices.Sorted(maps.Keys(req.Header)) {
+			if a.headers[strings.ToLower(name)] {
+				enc.AddString(name, strings.Join(req.Header[name], ", "))
+			}
+		}
+		return nil
+	})
+}
+
+// clientIP returns the address of the client. Behind API Gateway the connection comes from AWS, so the source IP of
+// the gateway request context is used instead.
+func clientIP(req *http.Request) string {
+	if gw, ok := core.GetAPIGatewayContextFromContext(req.Context()); ok && gw.Identity.SourceIP != "" {
+		return gw.Identity.SourceIP
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
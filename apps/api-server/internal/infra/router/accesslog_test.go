@@ -0,0 +1,202 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/awslabs/aws-lambda-go-api-proxy/core"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	mock_controller "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/controller/mock"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger/loggertest"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestAccessLog(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            AccessLogConfig
+		path           string
+		mockSetup      func(m *mock_controller.MockController)
+		expectLogged   bool
+		expectedLevel  zapcore.Level
+		expectedRoute  string
+		expectedStatus int64
+	}{
+		{
+			name: "Success: Logged with the route pattern",
+			cfg:  AccessLogConfig{SampleRate: 1},
+			path: "/jobs/1",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetJob(gomock.Any(), "1").Return(&model.Job{ID: "1"}, nil)
+			},
+			expectLogged:   true,
+			expectedLevel:  zapcore.InfoLevel,
+			expectedRoute:  "/jobs/{id}",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Success: Not logged when sampled out",
+			cfg:  AccessLogConfig{SampleRate: 0},
+			path: "/jobs/1",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetJob(gomock.Any(), "1").Return(&model.Job{ID: "1"}, nil)
+			},
+		},
+		{
+			name:           "Client error: Always logged",
+			cfg:            AccessLogConfig{SampleRate: 0},
+			path:           "/unknown",
+			mockSetup:      func(m *mock_controller.MockController) {},
+			expectLogged:   true,
+			expectedLevel:  zapcore.InfoLevel,
+			expectedRoute:  unmatchedRoute,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "Server error: Logged as a warning",
+			cfg:  AccessLogConfig{SampleRate: 0},
+			path: "/jobs/1",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetJob(gomock.Any(), "1").Return(nil, apperr.New(apperr.UpstreamUnavailable, "upstream returned status 503"))
+			},
+			expectLogged:   true,
+			expectedLevel:  zapcore.WarnLevel,
+			expectedRoute:  "/jobs/{id}",
+			expectedStatus: http.StatusBadGateway,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			logs := loggertest.Setup(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockController := mock_controller.NewMockController(ctrl)
+			tt.mockSetup(mockController)
+			router := NewRouter(mockController, tt.cfg)
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set("X-Request-Id", "trace-1")
+			req.Header.Set("User-Agent", "curl/8.0")
+			rec := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(rec, req)
+
+			// Assert
+			entries := logs.FilterMessage("Request handled").All()
+			if !tt.expectLogged {
+				if len(entries) != 0 {
+					t.Errorf("Expected no access log, got %v", entries[0].ContextMap())
+				}
+				return
+			}
+			if len(entries) != 1 {
+				t.Fatalf("Expected 1 access log, got %d", len(entries))
+			}
+			entry := entries[0]
+			if entry.Level != tt.expectedLevel {
+				t.Errorf("Expected level %s, got %s", tt.expectedLevel, entry.Level)
+			}
+			fields := entry.ContextMap()
+			expected := map[string]any{
+				"method":     http.MethodGet,
+				"route":      tt.expectedRoute,
+				"path":       tt.path,
+				"status":     tt.expectedStatus,
+				"bytes":      int64(rec.Body.Len()),
+				"user_agent": "curl/8.0",
+				"client_ip":  "192.0.2.1",
+				"trace_id":   "trace-1",
+			}
+			for key, value := range expected {
+				if fields[key] != value {
+					t.Errorf("Expected %s=%v, got %v", key, value, fields[key])
+				}
+			}
+			if _, ok := fields["latency"]; !ok {
+				t.Error("Expected the latency to be logged")
+			}
+		})
+	}
+}
+
+func TestAccessLog_Redaction(t *testing.T) {
+	// Arrange
+	logs := loggertest.Setup(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	router := NewRouter(mock_controller.NewMockController(ctrl), AccessLogConfig{
+		SampleRate:        1,
+		Headers:           []string{"accept", "Referer"},
+		RedactQueryParams: []string{"Token"},
+	})
+	req := httptest.NewRequest(http.MethodGet, "/?token=secret-token&q=go", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	req.Header.Set("Proxy-Authorization", "Basic secret")
+	req.Header.Set("X-Amz-Security-Token", "secret-token")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Referer", "https://example.com/")
+
+	// Act
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	// Assert: 大文字小文字を区別せずに、指定したヘッダーだけを出力してクエリの値を伏せる
+	entries := logs.FilterMessage("Request handled").All()
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 access log, got %d", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if fields["query"] != "q=go&token="+redacted {
+		t.Errorf("Expected the token to be redacted, got %v", fields["query"])
+	}
+	headers, _ := fields["headers"].(map[string]any)
+	expected := map[string]any{"Accept": "application/json", "Referer": "https://example.com/"}
+	if !reflect.DeepEqual(headers, expected) {
+		t.Errorf("Expected headers %v, got %v", expected, headers)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name       string
+		remoteAddr string
+		sourceIP   string
+		expectedIP string
+	}{
+		{name: "Remote address without API Gateway", remoteAddr: "198.51.100.7:54321", expectedIP: "198.51.100.7"},
+		{name: "Source IP of API Gateway", remoteAddr: "10.0.0.1:443", sourceIP: "203.0.113.9", expectedIP: "203.0.113.9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+			if tt.sourceIP != "" {
+				apiReq := events.APIGatewayProxyRequest{HTTPMethod: http.MethodGet, Path: "/jobs", RequestContext: events.APIGatewayProxyRequestContext{Identity: events.APIGatewayRequestIdentity{SourceIP: tt.sourceIP}}}
+				gwReq, err := (&core.RequestAccessor{}).EventToRequestWithContext(context.Background(), apiReq)
+				if err != nil {
+					t.Fatalf("Failed to build API Gateway request: %v", err)
+				}
+				req = req.WithContext(gwReq.Context())
+			}
+			req.RemoteAddr = tt.remoteAddr
+
+			// Act
+			ip := clientIP(req)
+
+			// Assert
+			if ip != tt.expectedIP {
+				t.Errorf("Expected '%s', got '%s'", tt.expectedIP, ip)
+			}
+		})
+	}
+}
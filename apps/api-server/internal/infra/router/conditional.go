@@ -0,0 +1,67 @@
+package router
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Cache-Control of the cacheable responses. Jobs are refreshed from the upstreams every few minutes, so shared caches
+// may serve a listing for a minute and a job detail for five, and keep serving it while they revalidate.
+const (
+	jobsCacheControl = "public, max-age=60, stale-while-revalidate=300"
+	jobCacheControl  = "public, max-age=300, stale-while-revalidate=600"
+)
+
+// writeCacheableJSON writes v as a 200 JSON response with a strong ETag of its content, or a 304 Not Modified when the
+// request's validators still match. lastModified is sent as Last-Modified unless it is zero.
+func writeCacheableJSON(w http.ResponseWriter, req *http.Request, v any, cacheControl string, lastModified time.Time) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(v); err != nil {
+		writeError(w, req, err, "Failed to encode response")
+		return
+	}
+	sum := sha256.Sum256(body.Bytes())
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	h := w.Header()
+	h.Set("ETag", etag)
+	h.Set("Cache-Control", cacheControl)
+	if !lastModified.IsZero() {
+		h.Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if notModified(req, etag, lastModified) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	h.Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body.Bytes())
+}
+
+// notModified evaluates If-None-Match, or If-Modified-Since when there is no If-None-Match, as RFC 9110 prescribes for GET
+func notModified(req *http.Request, etag string, lastModified time.Time) bool {
+	if inm := req.Header.Get("If-None-Match"); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimSpace(candidate)
+			// GET compares entity tags weakly, so a W/ tag of the same value matches too
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ims := req.Header.Get("If-Modified-Since"); ims != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ims)
+		// Last-Modified has a resolution of one second
+		return err == nil && !lastModified.Truncate(time.Second).After(since)
+	}
+	return false
+}
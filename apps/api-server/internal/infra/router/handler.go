@@ -3,11 +3,17 @@ package router
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/chi/v5/middleware"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/health"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/controller"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/buildinfo"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 // Router wraps the chi router with dependencies
@@ -16,14 +22,20 @@ type Router struct {
 	controller controller.Controller
 }
 
-// NewRouter creates a new router with all handlers
-func NewRouter(ctrl controller.Controller) *Router {
+// NewRouter creates a new router with all handlers, logging requests as accessLog says
+func NewRouter(ctrl controller.Controller, accessLog AccessLogConfig) *Router {
 	r := chi.NewRouter()
 
 	// Middleware
-	r.Use(middleware.Logger)
-	r.Use(middleware.Recoverer)
-	r.Use(middleware.RequestID)
+	r.Use(traceID)
+	r.Use(traceSpan)
+	r.Use(recordMetrics)
+	r.Use(newAccessLog(accessLog).middleware)
+	r.Use(recoverer)
+
+	// Error responses for unmatched routes
+	r.NotFound(handleNotFound)
+	r.MethodNotAllowed(handleMethodNotAllowed)
 
 	router := &Router{
 		Mux:        r,
@@ -32,11 +44,31 @@ func NewRouter(ctrl controller.Controller) *Router {
 
 	// Routes
 	r.Get("/", router.handleRoot)
+	r.Get("/healthz", router.handleHealthz)
+	r.Get("/readyz", router.handleReadyz)
 	r.Get("/jobs", router.handleGetJobs)
+	r.Get("/jobs/{id}", router.handleGetJob)
+	if h := metrics.Handler(); h != nil {
+		r.Method(http.MethodGet, "/metrics", h)
+	}
 
 	return router
 }
 
+// EnableDebugEndpoints serves /debug/*. They expose the internal state of the ingestion, so they are only enabled when
+// the deployment asks for them.
+func (r *Router) EnableDebugEndpoints() {
+	r.Get("/debug/dedup", r.handleGetDedupReport)
+	r.Get("/debug/breakers", r.handleGetBreakers)
+}
+
+// EnableAdminEndpoints serves /admin/*. They change the running process and are not authenticated, so they are only
+// enabled when the deployment asks for them.
+func (r *Router) EnableAdminEndpoints() {
+	r.Get("/admin/log-level", r.handleGetLogLevel)
+	r.Put("/admin/log-level", r.handlePutLogLevel)
+}
+
 // handleRoot is a health check endpoint
 func (r *Router) handleRoot(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
@@ -52,26 +84,143 @@ func (r *Router) handleRoot(w http.ResponseWriter, req *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
-// handleGetJobs fetches jobs from the controller
+// handleHealthz is the liveness probe. It checks no dependency, so that a failing upstream or store does not get a
+// process restarted that would fail the same way.
+func (r *Router) handleHealthz(w http.ResponseWriter, req *http.Request) {
+	response := map[string]any{
+		"status": health.StatusUp,
+		"build":  buildinfo.Get(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+// handleReadyz is the readiness probe. It answers 503 while a dependency is down, and 200 while the service is up or
+// degraded, since a degraded service still serves requests.
+func (r *Router) handleReadyz(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	logger.Debug(ctx, "GET /readyz endpoint called")
+
+	report := r.controller.GetReadiness(ctx)
+	status := http.StatusOK
+	if report.Status == health.StatusDown {
+		status = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(report)
+}
+
+// handleGetJobs fetches jobs from the controller.
+// A page has no reliable modification time, since a deleted job leaves no timestamp behind, so it is validated by ETag only.
 func (r *Router) handleGetJobs(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 	logger.Info(ctx, "GET /jobs endpoint called")
 
-	jobs, err := r.controller.GetJobs(ctx)
+	query, violations := parseJobQuery(req.URL.Query())
+	if err := query.Validate(); err != nil {
+		violations = append(violations, apperr.FieldsOf(err)...)
+	}
+	if len(violations) > 0 {
+		writeError(w, req, apperr.Invalid(violations), "Invalid query parameters")
+		return
+	}
+
+	page, err := r.controller.GetJobs(ctx, query)
+	if err != nil {
+		logger.Error(ctx, "Failed to fetch jobs", zap.String("error_code", string(apperr.KindOf(err))), zap.Error(err))
+		writeError(w, req, err, "Failed to fetch jobs")
+		return
+	}
+
+	writeCacheableJSON(w, req, page, jobsCacheControl, time.Time{})
+}
+
+// handleGetJob fetches a single job from the controller
+func (r *Router) handleGetJob(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	id := chi.URLParam(req, "id")
+	logger.Info(ctx, "GET /jobs/{id} endpoint called", zap.String("job_id", id))
+
+	job, err := r.controller.GetJob(ctx, id)
+	if err != nil {
+		logger.Error(ctx, "Failed to fetch job", zap.String("error_code", string(apperr.KindOf(err))), zap.Error(err))
+		writeError(w, req, err, "Failed to fetch job")
+		return
+	}
+
+	writeCacheableJSON(w, req, job, jobCacheControl, job.UpdatedAt)
+}
+
+// handleGetDedupReport explains which postings the last ingestion merged, optionally for a single job
+func (r *Router) handleGetDedupReport(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	jobID := req.URL.Query().Get("job_id")
+	logger.Info(ctx, "GET /debug/dedup endpoint called", zap.String("job_id", jobID))
+
+	report, err := r.controller.GetDedupReport(ctx, jobID)
+	if err != nil {
+		logger.Error(ctx, "Failed to fetch dedup report", zap.String("error_code", string(apperr.KindOf(err))), zap.Error(err))
+		writeError(w, req, err, "Failed to fetch dedup report")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(report)
+}
+
+// handleGetBreakers returns the circuit breaker state of every source
+func (r *Router) handleGetBreakers(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	logger.Info(ctx, "GET /debug/breakers endpoint called")
+
+	response := map[string]any{
+		"breakers": r.controller.GetBreakers(ctx),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+// logLevelBody is the request and response body of /admin/log-level
+type logLevelBody struct {
+	Level string `json:"level"`
+}
+
+// handleGetLogLevel returns the current log level
+func (r *Router) handleGetLogLevel(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(logLevelBody{Level: logger.Level().String()})
+}
+
+// handlePutLogLevel changes the log level of the running process until it restarts
+func (r *Router) handlePutLogLevel(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var body logLevelBody
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		writeError(w, req, apperr.Invalid([]apperr.FieldViolation{{Field: "level", Reason: "request body must be a JSON object with a level"}}), "Invalid log level")
+		return
+	}
+	level, err := zapcore.ParseLevel(body.Level)
 	if err != nil {
-		logger.Error(ctx, "Failed to fetch jobs")
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusInternalServerError)
-		json.NewEncoder(w).Encode(map[string]string{
-			"error": "Failed to fetch jobs",
-		})
+		writeError(w, req, apperr.Invalid([]apperr.FieldViolation{{Field: "level", Reason: "must be one of debug, info, warn, error, dpanic, panic, fatal"}}), "Invalid log level")
 		return
 	}
 
+	previous := logger.Level()
+	logger.SetLevel(level)
+	logger.Warn(ctx, "Log level changed", zap.Stringer("from", previous), zap.Stringer("to", level))
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"jobs":  jobs,
-		"count": len(jobs),
-	})
+	json.NewEncoder(w).Encode(logLevelBody{Level: level.String()})
 }
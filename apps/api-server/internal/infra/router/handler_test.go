@@ -5,11 +5,21 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/dedup"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/health"
 	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
 	mock_controller "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/controller/mock"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/buildinfo"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zapcore"
 )
 
 func TestRouter_HandleRoot(t *testing.T) {
@@ -36,7 +46,7 @@ func TestRouter_HandleRoot(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockController := mock_controller.NewMockController(ctrl)
-			router := NewRouter(mockController)
+			router := NewRouter(mockController, AccessLogConfig{})
 
 			req := httptest.NewRequest(http.MethodGet, "/", nil)
 			w := httptest.NewRecorder()
@@ -77,6 +87,7 @@ func TestRouter_HandleGetJobs(t *testing.T) {
 		expectedStatusCode    int
 		expectedCount         int
 		expectedError         string
+		expectedCode          string
 		checkFirstJobTitle    string
 		checkFirstJobLocation string
 		isErrorResponse       bool
@@ -84,7 +95,7 @@ func TestRouter_HandleGetJobs(t *testing.T) {
 		{
 			name: "Success: Multiple jobs are returned",
 			mockSetup: func(m *mock_controller.MockController) {
-				m.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{
+				m.EXPECT().GetJobs(gomock.Any(), gomock.Any()).Return(&model.JobPage{Jobs: []model.Job{
 					{
 						ID:          "1",
 						Title:       "Senior Go Developer",
@@ -99,7 +110,7 @@ func TestRouter_HandleGetJobs(t *testing.T) {
 						Location:    "Osaka",
 						Description: "Exciting role",
 					},
-				}, nil)
+				}}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 			expectedCount:      2,
@@ -109,7 +120,7 @@ func TestRouter_HandleGetJobs(t *testing.T) {
 		{
 			name: "Success: Empty job list is returned",
 			mockSetup: func(m *mock_controller.MockController) {
-				m.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{}, nil)
+				m.EXPECT().GetJobs(gomock.Any(), gomock.Any()).Return(&model.JobPage{Jobs: []model.Job{}}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 			expectedCount:      0,
@@ -118,7 +129,7 @@ func TestRouter_HandleGetJobs(t *testing.T) {
 		{
 			name: "Error: Controller returns error",
 			mockSetup: func(m *mock_controller.MockController) {
-				m.EXPECT().GetJobs(gomock.Any()).Return(nil, errors.New("database connection failed"))
+				m.EXPECT().GetJobs(gomock.Any(), gomock.Any()).Return(nil, errors.New("database connection failed"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 			expectedError:      "Failed to fetch jobs",
@@ -127,7 +138,7 @@ func TestRouter_HandleGetJobs(t *testing.T) {
 		{
 			name: "Success: Single job is returned",
 			mockSetup: func(m *mock_controller.MockController) {
-				m.EXPECT().GetJobs(gomock.Any()).Return([]model.Job{
+				m.EXPECT().GetJobs(gomock.Any(), gomock.Any()).Return(&model.JobPage{Jobs: []model.Job{
 					{
 						ID:          "99",
 						Title:       "Single Job",
@@ -135,7 +146,7 @@ func TestRouter_HandleGetJobs(t *testing.T) {
 						Location:    "Fukuoka",
 						Description: "Single description",
 					},
-				}, nil)
+				}}, nil)
 			},
 			expectedStatusCode:    http.StatusOK,
 			expectedCount:         1,
@@ -145,10 +156,61 @@ func TestRouter_HandleGetJobs(t *testing.T) {
 		{
 			name: "Error: Service timeout error",
 			mockSetup: func(m *mock_controller.MockController) {
-				m.EXPECT().GetJobs(gomock.Any()).Return(nil, errors.New("request timeout"))
+				m.EXPECT().GetJobs(gomock.Any(), gomock.Any()).Return(nil, errors.New("request timeout"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 			expectedError:      "Failed to fetch jobs",
+			expectedCode:       "internal",
+			isErrorResponse:    true,
+		},
+		{
+			name: "Error: Upstream unavailable is mapped to 502",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetJobs(gomock.Any(), gomock.Any()).Return(nil, apperr.New(apperr.UpstreamUnavailable, "upstream returned status 503"))
+			},
+			expectedStatusCode: http.StatusBadGateway,
+			expectedError:      "Failed to fetch jobs",
+			expectedCode:       "upstream_unavailable",
+			isErrorResponse:    true,
+		},
+		{
+			name: "Error: Upstream timeout is mapped to 504",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetJobs(gomock.Any(), gomock.Any()).Return(nil, apperr.New(apperr.UpstreamTimeout, "upstream request timed out"))
+			},
+			expectedStatusCode: http.StatusGatewayTimeout,
+			expectedError:      "Failed to fetch jobs",
+			expectedCode:       "upstream_timeout",
+			isErrorResponse:    true,
+		},
+		{
+			name: "Error: Rate limited is mapped to 429",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetJobs(gomock.Any(), gomock.Any()).Return(nil, apperr.New(apperr.RateLimited, "upstream returned status 429"))
+			},
+			expectedStatusCode: http.StatusTooManyRequests,
+			expectedError:      "Failed to fetch jobs",
+			expectedCode:       "rate_limited",
+			isErrorResponse:    true,
+		},
+		{
+			name: "Error: Not found is mapped to 404",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetJobs(gomock.Any(), gomock.Any()).Return(nil, apperr.New(apperr.NotFound, "upstream returned status 404"))
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedError:      "Failed to fetch jobs",
+			expectedCode:       "not_found",
+			isErrorResponse:    true,
+		},
+		{
+			name: "Error: Invalid argument is mapped to 400",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetJobs(gomock.Any(), gomock.Any()).Return(nil, apperr.New(apperr.InvalidArgument, "invalid query"))
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "Failed to fetch jobs",
+			expectedCode:       "invalid_argument",
 			isErrorResponse:    true,
 		},
 	}
@@ -162,7 +224,7 @@ func TestRouter_HandleGetJobs(t *testing.T) {
 			mockController := mock_controller.NewMockController(ctrl)
 			tt.mockSetup(mockController)
 
-			router := NewRouter(mockController)
+			router := NewRouter(mockController, AccessLogConfig{})
 			req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
 			w := httptest.NewRecorder()
 
@@ -175,19 +237,32 @@ func TestRouter_HandleGetJobs(t *testing.T) {
 			}
 
 			// Assert: Content-Typeの検証
+			expectedContentType := "application/json"
+			if tt.isErrorResponse {
+				expectedContentType = problemContentType
+			}
 			contentType := w.Header().Get("Content-Type")
-			if contentType != "application/json" {
-				t.Errorf("Expected Content-Type 'application/json', got '%s'", contentType)
+			if contentType != expectedContentType {
+				t.Errorf("Expected Content-Type '%s', got '%s'", expectedContentType, contentType)
 			}
 
 			// Assert: レスポンスボディの検証
 			if tt.isErrorResponse {
-				var response map[string]string
+				var response Problem
 				if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
 					t.Fatalf("Failed to decode error response: %v", err)
 				}
-				if response["error"] != tt.expectedError {
-					t.Errorf("Expected error message '%s', got '%s'", tt.expectedError, response["error"])
+				if !strings.HasPrefix(response.Detail, tt.expectedError) {
+					t.Errorf("Expected detail to start with '%s', got '%s'", tt.expectedError, response.Detail)
+				}
+				if response.Status != tt.expectedStatusCode {
+					t.Errorf("Expected problem status %d, got %d", tt.expectedStatusCode, response.Status)
+				}
+				if tt.expectedCode != "" && response.Code != tt.expectedCode {
+					t.Errorf("Expected error code '%s', got '%s'", tt.expectedCode, response.Code)
+				}
+				if response.Instance != "/jobs" {
+					t.Errorf("Expected instance '/jobs', got '%s'", response.Instance)
 				}
 			} else {
 				var response map[string]interface{}
@@ -195,12 +270,8 @@ func TestRouter_HandleGetJobs(t *testing.T) {
 					t.Fatalf("Failed to decode response: %v", err)
 				}
 
-				count, ok := response["count"].(float64)
-				if !ok {
-					t.Fatal("Expected 'count' field in response")
-				}
-				if int(count) != tt.expectedCount {
-					t.Errorf("Expected count %d, got %d", tt.expectedCount, int(count))
+				if _, ok := response["total"]; ok {
+					t.Error("Expected 'total' to be omitted unless requested")
 				}
 
 				jobs, ok := response["jobs"].([]interface{})
@@ -235,3 +306,693 @@ func TestRouter_HandleGetJobs(t *testing.T) {
 		})
 	}
 }
+
+func TestRouter_HandleGetJob(t *testing.T) {
+	tests := []struct {
+		name               string
+		path               string
+		mockSetup          func(*mock_controller.MockController)
+		expectedStatusCode int
+		expectedTitle      string
+		expectedCode       string
+	}{
+		{
+			name: "Success: Job detail is returned",
+			path: "/jobs/1",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetJob(gomock.Any(), "1").Return(&model.Job{
+					ID:          "1",
+					Title:       "Senior Go Developer",
+					Company:     "Tech Company",
+					Location:    "Tokyo",
+					Description: "Great opportunity",
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedTitle:      "Senior Go Developer",
+		},
+		{
+			name: "Error: Unknown ID returns 404 problem",
+			path: "/jobs/missing",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetJob(gomock.Any(), "missing").Return(nil, apperr.New(apperr.NotFound, `job "missing" not found`))
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedCode:       "not_found",
+		},
+		{
+			name: "Error: Upstream timeout returns 504 problem",
+			path: "/jobs/1",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetJob(gomock.Any(), "1").Return(nil, apperr.New(apperr.UpstreamTimeout, "upstream request timed out"))
+			},
+			expectedStatusCode: http.StatusGatewayTimeout,
+			expectedCode:       "upstream_timeout",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockController := mock_controller.NewMockController(ctrl)
+			tt.mockSetup(mockController)
+
+			router := NewRouter(mockController, AccessLogConfig{})
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if tt.expectedCode != "" {
+				var problem Problem
+				if err := json.NewDecoder(w.Body).Decode(&problem); err != nil {
+					t.Fatalf("Failed to decode problem: %v", err)
+				}
+				if problem.Code != tt.expectedCode {
+					t.Errorf("Expected code '%s', got '%s'", tt.expectedCode, problem.Code)
+				}
+				if problem.Instance != tt.path {
+					t.Errorf("Expected instance '%s', got '%s'", tt.path, problem.Instance)
+				}
+				if w.Header().Get("Cache-Control") != "no-store" || w.Header().Get("ETag") != "" {
+					t.Errorf("Expected an uncacheable problem, got Cache-Control '%s', ETag '%s'", w.Header().Get("Cache-Control"), w.Header().Get("ETag"))
+				}
+				return
+			}
+
+			var job model.Job
+			if err := json.NewDecoder(w.Body).Decode(&job); err != nil {
+				t.Fatalf("Failed to decode job: %v", err)
+			}
+			if job.Title != tt.expectedTitle {
+				t.Errorf("Expected title '%s', got '%s'", tt.expectedTitle, job.Title)
+			}
+		})
+	}
+}
+
+func TestRouter_ConditionalGet(t *testing.T) {
+	updatedAt := time.Date(2026, 4, 1, 9, 30, 15, 500, time.UTC)
+	page := &model.JobPage{Jobs: []model.Job{{ID: "1", Title: "Senior Go Developer", Company: "Tech Company", Location: "Tokyo", Description: "Go"}}}
+	job := &model.Job{ID: "1", Title: "Senior Go Developer", Company: "Tech Company", Location: "Tokyo", Description: "Go", UpdatedAt: updatedAt}
+
+	tests := []struct {
+		name                 string
+		path                 string
+		headers              func(etag string) map[string]string
+		expectedStatusCode   int
+		expectedCacheControl string
+		expectLastModified   bool
+	}{
+		{
+			name:                 "Jobs: No validators returns 200",
+			path:                 "/jobs",
+			headers:              func(string) map[string]string { return nil },
+			expectedStatusCode:   http.StatusOK,
+			expectedCacheControl: "public, max-age=60, stale-while-revalidate=300",
+		},
+		{
+			name:                 "Jobs: Matching If-None-Match returns 304",
+			path:                 "/jobs",
+			headers:              func(etag string) map[string]string { return map[string]string{"If-None-Match": etag} },
+			expectedStatusCode:   http.StatusNotModified,
+			expectedCacheControl: "public, max-age=60, stale-while-revalidate=300",
+		},
+		{
+			name:                 "Jobs: Weak tag in a list matches",
+			path:                 "/jobs",
+			headers:              func(etag string) map[string]string { return map[string]string{"If-None-Match": `"other", W/` + etag} },
+			expectedStatusCode:   http.StatusNotModified,
+			expectedCacheControl: "public, max-age=60, stale-while-revalidate=300",
+		},
+		{
+			name:                 "Jobs: Stale If-None-Match returns 200",
+			path:                 "/jobs",
+			headers:              func(string) map[string]string { return map[string]string{"If-None-Match": `"stale"`} },
+			expectedStatusCode:   http.StatusOK,
+			expectedCacheControl: "public, max-age=60, stale-while-revalidate=300",
+		},
+		{
+			name: "Jobs: If-Modified-Since is ignored without a modification time",
+			path: "/jobs",
+			headers: func(string) map[string]string {
+				return map[string]string{"If-Modified-Since": "Wed, 01 Apr 2026 10:00:00 GMT"}
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedCacheControl: "public, max-age=60, stale-while-revalidate=300",
+		},
+		{
+			name:                 "Job: Matching If-None-Match returns 304",
+			path:                 "/jobs/1",
+			headers:              func(etag string) map[string]string { return map[string]string{"If-None-Match": etag} },
+			expectedStatusCode:   http.StatusNotModified,
+			expectedCacheControl: "public, max-age=300, stale-while-revalidate=600",
+			expectLastModified:   true,
+		},
+		{
+			name: "Job: If-Modified-Since at the last modification returns 304",
+			path: "/jobs/1",
+			headers: func(string) map[string]string {
+				return map[string]string{"If-Modified-Since": "Wed, 01 Apr 2026 09:30:15 GMT"}
+			},
+			expectedStatusCode:   http.StatusNotModified,
+			expectedCacheControl: "public, max-age=300, stale-while-revalidate=600",
+			expectLastModified:   true,
+		},
+		{
+			name: "Job: If-Modified-Since before the last modification returns 200",
+			path: "/jobs/1",
+			headers: func(string) map[string]string {
+				return map[string]string{"If-Modified-Since": "Wed, 01 Apr 2026 09:00:00 GMT"}
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedCacheControl: "public, max-age=300, stale-while-revalidate=600",
+			expectLastModified:   true,
+		},
+		{
+			name: "Job: If-None-Match takes precedence over If-Modified-Since",
+			path: "/jobs/1",
+			headers: func(string) map[string]string {
+				return map[string]string{"If-None-Match": `"stale"`, "If-Modified-Since": "Wed, 01 Apr 2026 10:00:00 GMT"}
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedCacheControl: "public, max-age=300, stale-while-revalidate=600",
+			expectLastModified:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange: 1回目のリクエストで ETag を取得する
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockController := mock_controller.NewMockController(ctrl)
+			mockController.EXPECT().GetJobs(gomock.Any(), gomock.Any()).Return(page, nil).AnyTimes()
+			mockController.EXPECT().GetJob(gomock.Any(), "1").Return(job, nil).AnyTimes()
+			router := NewRouter(mockController, AccessLogConfig{})
+
+			first := httptest.NewRecorder()
+			router.ServeHTTP(first, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			etag := first.Header().Get("ETag")
+			if !strings.HasPrefix(etag, `"`) || !strings.HasSuffix(etag, `"`) {
+				t.Fatalf("Expected a strong ETag, got '%s'", etag)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			for key, value := range tt.headers(etag) {
+				req.Header.Set(key, value)
+			}
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			if w.Code != tt.expectedStatusCode {
+				t.Fatalf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+			if w.Header().Get("ETag") != etag {
+				t.Errorf("Expected ETag '%s', got '%s'", etag, w.Header().Get("ETag"))
+			}
+			if w.Header().Get("Cache-Control") != tt.expectedCacheControl {
+				t.Errorf("Expected Cache-Control '%s', got '%s'", tt.expectedCacheControl, w.Header().Get("Cache-Control"))
+			}
+			if lastModified := w.Header().Get("Last-Modified"); (lastModified != "") != tt.expectLastModified {
+				t.Errorf("Unexpected Last-Modified '%s'", lastModified)
+			} else if tt.expectLastModified && lastModified != "Wed, 01 Apr 2026 09:30:15 GMT" {
+				t.Errorf("Expected Last-Modified of the job, got '%s'", lastModified)
+			}
+			if tt.expectedStatusCode == http.StatusNotModified && w.Body.Len() != 0 {
+				t.Errorf("Expected an empty 304 body, got %q", w.Body.String())
+			}
+			if tt.expectedStatusCode == http.StatusOK && w.Body.String() != first.Body.String() {
+				t.Errorf("Expected the same body as the first response")
+			}
+		})
+	}
+}
+
+func TestRouter_ConditionalGet_ContentChange(t *testing.T) {
+	// Arrange: 2回目のリクエストまでに Job が更新される
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockController := mock_controller.NewMockController(ctrl)
+	gomock.InOrder(
+		mockController.EXPECT().GetJobs(gomock.Any(), gomock.Any()).Return(&model.JobPage{Jobs: []model.Job{{ID: "1", Title: "Go Developer"}}}, nil),
+		mockController.EXPECT().GetJobs(gomock.Any(), gomock.Any()).Return(&model.JobPage{Jobs: []model.Job{{ID: "1", Title: "Senior Go Developer"}}}, nil),
+	)
+	router := NewRouter(mockController, AccessLogConfig{})
+
+	first := httptest.NewRecorder()
+	router.ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/jobs", nil))
+	req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+	req.Header.Set("If-None-Match", first.Header().Get("ETag"))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if w.Header().Get("ETag") == first.Header().Get("ETag") {
+		t.Errorf("Expected a new ETag for the changed content")
+	}
+}
+
+func TestRouter_HandleGetDedupReport(t *testing.T) {
+	tests := []struct {
+		name               string
+		path               string
+		disabled           bool
+		mockSetup          func(*mock_controller.MockController)
+		expectedStatusCode int
+		expectedCanonical  string
+		expectedCode       string
+	}{
+		{
+			name: "Success: Cluster of the job is returned",
+			path: "/debug/dedup?job_id=feed:9",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetDedupReport(gomock.Any(), "feed:9").Return(&dedup.Report{Jobs: 2, Merged: 1, Clusters: []dedup.Cluster{{
+					CanonicalID: "ats:1",
+					Members:     []dedup.Member{{ID: "ats:1"}, {ID: "feed:9"}},
+					Decisions:   []dedup.Decision{{ID: "feed:9", MatchedID: "ats:1", Distance: 2}},
+				}}}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedCanonical:  "ats:1",
+		},
+		{
+			name: "Error: No report returns 404 problem",
+			path: "/debug/dedup",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetDedupReport(gomock.Any(), "").Return(nil, apperr.New(apperr.NotFound, "no ingestion run has completed yet"))
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedCode:       "not_found",
+		},
+		{
+			name:               "Error: Disabled debug endpoints return 404 problem",
+			path:               "/debug/dedup?job_id=feed:9",
+			disabled:           true,
+			mockSetup:          func(m *mock_controller.MockController) {},
+			expectedStatusCode: http.StatusNotFound,
+			expectedCode:       "not_found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockController := mock_controller.NewMockController(ctrl)
+			tt.mockSetup(mockController)
+
+			router := NewRouter(mockController, AccessLogConfig{})
+			if !tt.disabled {
+				router.EnableDebugEndpoints()
+			}
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+
+			if tt.expectedCode != "" {
+				var problem Problem
+				if err := json.NewDecoder(w.Body).Decode(&problem); err != nil {
+					t.Fatalf("Failed to decode problem: %v", err)
+				}
+				if problem.Code != tt.expectedCode {
+					t.Errorf("Expected code '%s', got '%s'", tt.expectedCode, problem.Code)
+				}
+				return
+			}
+
+			var report dedup.Report
+			if err := json.NewDecoder(w.Body).Decode(&report); err != nil {
+				t.Fatalf("Failed to decode report: %v", err)
+			}
+			if len(report.Clusters) != 1 || report.Clusters[0].CanonicalID != tt.expectedCanonical {
+				t.Errorf("Expected cluster of '%s', got %+v", tt.expectedCanonical, report.Clusters)
+			}
+		})
+	}
+}
+
+func TestRouter_HandleGetBreakers(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockController := mock_controller.NewMockController(ctrl)
+	mockController.EXPECT().GetBreakers(gomock.Any()).Return([]httpclient.BreakerStatus{
+		{Name: "greenhouse-acme", State: httpclient.BreakerOpen, ConsecutiveFailures: 5, LastError: "upstream returned status 503"},
+		{Name: "lever-acme", State: httpclient.BreakerClosed},
+	})
+
+	router := NewRouter(mockController, AccessLogConfig{})
+	router.EnableDebugEndpoints()
+	req := httptest.NewRequest(http.MethodGet, "/debug/breakers", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	var response struct {
+		Breakers []httpclient.BreakerStatus `json:"breakers"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(response.Breakers) != 2 || response.Breakers[0].State != httpclient.BreakerOpen {
+		t.Errorf("Unexpected breakers: %+v", response.Breakers)
+	}
+}
+
+func TestRouter_Healthz(t *testing.T) {
+	// Arrange: 依存先を確認しないため Controller は呼ばれない
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	router := NewRouter(mock_controller.NewMockController(ctrl), AccessLogConfig{})
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	var response struct {
+		Status health.Status  `json:"status"`
+		Build  buildinfo.Info `json:"build"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if response.Status != health.StatusUp || response.Build != buildinfo.Get() {
+		t.Errorf("Unexpected response: %+v", response)
+	}
+}
+
+func TestRouter_Readyz(t *testing.T) {
+	tests := []struct {
+		name               string
+		status             health.Status
+		expectedStatusCode int
+	}{
+		{name: "Up: 200", status: health.StatusUp, expectedStatusCode: http.StatusOK},
+		{name: "Degraded: 200, still serving", status: health.StatusDegraded, expectedStatusCode: http.StatusOK},
+		{name: "Down: 503", status: health.StatusDown, expectedStatusCode: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			report := health.Report{
+				Status:     tt.status,
+				CheckedAt:  time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC),
+				Build:      buildinfo.Info{Commit: "0123456789abcdef", BuildTime: "2026-04-01T08:00:00Z", GoVersion: "go1.25.0"},
+				Components: []health.Component{{Name: "repository", Status: tt.status, LatencyMs: 0.5}},
+			}
+			mockController := mock_controller.NewMockController(ctrl)
+			mockController.EXPECT().GetReadiness(gomock.Any()).Return(report)
+			router := NewRouter(mockController, AccessLogConfig{})
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			// Assert
+			if w.Code != tt.expectedStatusCode {
+				t.Fatalf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+			if got := w.Header().Get("Cache-Control"); got != "no-store" {
+				t.Errorf("Expected Cache-Control 'no-store', got '%s'", got)
+			}
+			var response health.Report
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if !reflect.DeepEqual(response, report) {
+				t.Errorf("Report mismatch:\n  expected: %+v\n  got:      %+v", report, response)
+			}
+		})
+	}
+}
+
+func TestRouter_LogLevel(t *testing.T) {
+	tests := []struct {
+		name               string
+		method             string
+		body               string
+		disabled           bool
+		expectedStatusCode int
+		expectedLevel      string
+		expectedCode       string
+	}{
+		{
+			name:               "Success: Current level is returned",
+			method:             http.MethodGet,
+			expectedStatusCode: http.StatusOK,
+			expectedLevel:      "info",
+		},
+		{
+			name:               "Success: Level is changed",
+			method:             http.MethodPut,
+			body:               `{"level":"debug"}`,
+			expectedStatusCode: http.StatusOK,
+			expectedLevel:      "debug",
+		},
+		{
+			name:               "Error: Unknown level returns 400 problem",
+			method:             http.MethodPut,
+			body:               `{"level":"verbose"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedLevel:      "info",
+			expectedCode:       "invalid_argument",
+		},
+		{
+			name:               "Error: Malformed body returns 400 problem",
+			method:             http.MethodPut,
+			body:               `debug`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedLevel:      "info",
+			expectedCode:       "invalid_argument",
+		},
+		{
+			name:               "Error: Disabled admin endpoints return 404",
+			method:             http.MethodPut,
+			body:               `{"level":"debug"}`,
+			disabled:           true,
+			expectedStatusCode: http.StatusNotFound,
+			expectedLevel:      "info",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			t.Cleanup(func() { logger.SetLevel(zapcore.InfoLevel) })
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			router := NewRouter(mock_controller.NewMockController(ctrl), AccessLogConfig{})
+			if !tt.disabled {
+				router.EnableAdminEndpoints()
+			}
+			req := httptest.NewRequest(tt.method, "/admin/log-level", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			if w.Code != tt.expectedStatusCode {
+				t.Fatalf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+			if logger.Level().String() != tt.expectedLevel {
+				t.Errorf("Expected log level '%s', got '%s'", tt.expectedLevel, logger.Level())
+			}
+			if tt.disabled {
+				return
+			}
+			if tt.expectedCode != "" {
+				var problem Problem
+				if err := json.NewDecoder(w.Body).Decode(&problem); err != nil {
+					t.Fatalf("Failed to decode problem: %v", err)
+				}
+				if problem.Code != tt.expectedCode || len(problem.InvalidParams) != 1 || problem.InvalidParams[0].Field != "level" {
+					t.Errorf("Unexpected problem: %+v", problem)
+				}
+				return
+			}
+			var body struct {
+				Level string `json:"level"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if body.Level != tt.expectedLevel {
+				t.Errorf("Expected level '%s', got '%s'", tt.expectedLevel, body.Level)
+			}
+		})
+	}
+}
+
+func TestRouter_HandleGetJobs_Query(t *testing.T) {
+	remote := true
+
+	tests := []struct {
+		name               string
+		rawQuery           string
+		expectedQuery      *model.JobQuery
+		expectedStatusCode int
+		expectedFields     []string
+	}{
+		{
+			name:     "Success: Query parameters are parsed into JobQuery",
+			rawQuery: "q=go&location=Tokyo&company=Mercari&remote=true&employment_type=full_time&min_salary=8000000",
+			expectedQuery: &model.JobQuery{
+				Keyword:        "go",
+				Location:       "Tokyo",
+				Company:        "Mercari",
+				Remote:         &remote,
+				EmploymentType: model.EmploymentFullTime,
+				MinSalary:      8000000,
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Error: Every offending field is listed",
+			rawQuery:           "remote=maybe&min_salary=abc&employment_type=seasonal",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedFields:     []string{"remote", "min_salary", "employment_type"},
+		},
+		{
+			name:     "Success: Prefecture code is zero-padded",
+			rawQuery: "prefecture=1",
+			expectedQuery: &model.JobQuery{
+				Prefecture: "01",
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Error: Prefecture is not a number",
+			rawQuery:           "prefecture=tokyo",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedFields:     []string{"prefecture"},
+		},
+		{
+			name:     "Success: Paging parameters are parsed into JobQuery",
+			rawQuery: "limit=50&cursor=abc.def&include_total=true",
+			expectedQuery: &model.JobQuery{
+				Limit:        50,
+				Cursor:       "abc.def",
+				IncludeTotal: true,
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:     "Success: Sort parameters are parsed into JobQuery",
+			rawQuery: "q=go&sort=relevance&order=ASC",
+			expectedQuery: &model.JobQuery{
+				Keyword: "go",
+				Sort:    model.SortRelevance,
+				Order:   model.SortAsc,
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Error: Unknown sort key and order",
+			rawQuery:           "sort=popularity&order=up",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedFields:     []string{"sort", "order"},
+		},
+		{
+			name:               "Error: Invalid paging parameters",
+			rawQuery:           "limit=101&include_total=yes",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedFields:     []string{"include_total", "limit"},
+		},
+		{
+			name:               "Error: Negative min_salary",
+			rawQuery:           "min_salary=-1",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedFields:     []string{"min_salary"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockController := mock_controller.NewMockController(ctrl)
+			if tt.expectedQuery != nil {
+				mockController.EXPECT().GetJobs(gomock.Any(), *tt.expectedQuery).Return(&model.JobPage{Jobs: []model.Job{}}, nil)
+			}
+
+			router := NewRouter(mockController, AccessLogConfig{})
+			req := httptest.NewRequest(http.MethodGet, "/jobs?"+tt.rawQuery, nil)
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			if w.Code != tt.expectedStatusCode {
+				t.Fatalf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+			if len(tt.expectedFields) == 0 {
+				return
+			}
+
+			var problem Problem
+			if err := json.NewDecoder(w.Body).Decode(&problem); err != nil {
+				t.Fatalf("Failed to decode problem: %v", err)
+			}
+			if problem.Code != "invalid_argument" {
+				t.Errorf("Expected code 'invalid_argument', got '%s'", problem.Code)
+			}
+			if len(problem.InvalidParams) != len(tt.expectedFields) {
+				t.Fatalf("Expected %d invalid params, got %+v", len(tt.expectedFields), problem.InvalidParams)
+			}
+			for i, field := range tt.expectedFields {
+				if problem.InvalidParams[i].Field != field {
+					t.Errorf("InvalidParams[%d]: expected '%s', got '%s'", i, field, problem.InvalidParams[i].Field)
+				}
+			}
+		})
+	}
+}
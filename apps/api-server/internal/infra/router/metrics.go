@@ -0,0 +1,35 @@
+package router
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics"
+)
+
+// unmatchedRoute labels requests that matched no route, so that arbitrary paths do not create a series each
+const unmatchedRoute = "unmatched"
+
+// recordMetrics counts every request and records its latency per route, method and status
+func recordMetrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, req.ProtoMajor)
+		next.ServeHTTP(ww, req)
+
+		route := chi.RouteContext(req.Context()).RoutePattern()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		labels := []metrics.Label{metrics.L("route", route), metrics.L("method", req.Method), metrics.L("status", strconv.Itoa(status))}
+		metrics.Add(metrics.RequestsTotal, 1, labels...)
+		metrics.Observe(metrics.RequestDuration, float64(time.Since(start))/float64(time.Millisecond), labels...)
+	})
+}
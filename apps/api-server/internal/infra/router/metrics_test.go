@@ -0,0 +1,98 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	mock_controller "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/controller/mock"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics/metricstest"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRecordMetrics(t *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		mockSetup      func(m *mock_controller.MockController)
+		expectedLabels []metrics.Label
+	}{
+		{
+			name: "Labelled with the route pattern instead of the path",
+			path: "/jobs/1",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetJob(gomock.Any(), "1").Return(&model.Job{ID: "1"}, nil)
+			},
+			expectedLabels: []metrics.Label{metrics.L("route", "/jobs/{id}"), metrics.L("method", "GET"), metrics.L("status", "200")},
+		},
+		{
+			name:           "Unknown paths share one series",
+			path:           "/unknown/path",
+			mockSetup:      func(m *mock_controller.MockController) {},
+			expectedLabels: []metrics.Label{metrics.L("route", unmatchedRoute), metrics.L("method", "GET"), metrics.L("status", "404")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			recorder := metricstest.Setup(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockController := mock_controller.NewMockController(ctrl)
+			tt.mockSetup(mockController)
+			router := NewRouter(mockController, AccessLogConfig{})
+
+			// Act
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			// Assert
+			if got := recorder.Counter(metrics.RequestsTotal, tt.expectedLabels...); got != 1 {
+				t.Errorf("Expected 1 request labelled %v, got %v", tt.expectedLabels, got)
+			}
+			if got := recorder.Samples(metrics.RequestDuration, tt.expectedLabels...); len(got) != 1 {
+				t.Errorf("Expected 1 latency sample labelled %v, got %v", tt.expectedLabels, got)
+			}
+		})
+	}
+}
+
+func TestRouter_Metrics(t *testing.T) {
+	tests := []struct {
+		name               string
+		recorder           metrics.Recorder
+		expectedStatusCode int
+	}{
+		{name: "Prometheus: Metrics are served", recorder: metrics.NewPrometheus(), expectedStatusCode: http.StatusOK},
+		{name: "EMF: Endpoint is not registered", recorder: metrics.NewEMF(&strings.Builder{}, "Test"), expectedStatusCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			previous := metrics.SetRecorder(tt.recorder)
+			t.Cleanup(func() { metrics.SetRecorder(previous) })
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			router := NewRouter(mock_controller.NewMockController(ctrl), AccessLogConfig{})
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+			// Act
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+			// Assert
+			if rec.Code != tt.expectedStatusCode {
+				t.Fatalf("Expected status code %d, got %d", tt.expectedStatusCode, rec.Code)
+			}
+			if tt.expectedStatusCode == http.StatusOK && !strings.Contains(rec.Body.String(), `http_requests_total{method="GET",route="/",status="200"} 1`) {
+				t.Errorf("Expected the request to / to be counted, got\n%s", rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	problemContentType = "application/problem+json"
+	problemTypePrefix  = "urn:japan-tech-careers:problem:"
+)
+
+// Problem is an RFC 7807 problem details object
+type Problem struct {
+	Type      string `json:"type"`
+	Title     string `json:"title"`
+	Status    int    `json:"status"`
+	Detail    string `json:"detail,omitempty"`
+	Instance  string `json:"instance,omitempty"`
+	Code      string `json:"code"`
+	RequestID string `json:"request_id,omitempty"`
+	// InvalidParams lists every offending field of a 400 response
+	InvalidParams []apperr.FieldViolation `json:"invalid_params,omitempty"`
+}
+
+// problemTitles holds the short, human-readable summary for each error kind
+var problemTitles = map[apperr.Kind]string{
+	apperr.Internal:            "Internal Server Error",
+	apperr.NotFound:            "Not Found",
+	apperr.InvalidArgument:     "Invalid Argument",
+	apperr.UpstreamUnavailable: "Upstream Unavailable",
+	apperr.UpstreamTimeout:     "Upstream Timeout",
+	apperr.RateLimited:         "Rate Limited",
+}
+
+// statusForKind maps an error kind to the HTTP status code returned to clients
+func statusForKind(kind apperr.Kind) int {
+	switch kind {
+	case apperr.InvalidArgument:
+		return http.StatusBadRequest
+	case apperr.NotFound:
+		return http.StatusNotFound
+	case apperr.RateLimited:
+		return http.StatusTooManyRequests
+	case apperr.UpstreamUnavailable:
+		return http.StatusBadGateway
+	case apperr.UpstreamTimeout:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// newProblem builds a Problem with the given machine-readable code for req
+func newProblem(req *http.Request, code string, status int, title, detail string) Problem {
+	return Problem{
+		Type:      problemTypePrefix + code,
+		Title:     title,
+		Status:    status,
+		Detail:    detail,
+		Instance:  req.URL.RequestURI(),
+		Code:      code,
+		RequestID: logger.TraceIDFrom(req.Context()),
+	}
+}
+
+// writeProblem writes p as an application/problem+json response
+func writeProblem(w http.ResponseWriter, p Problem) {
+	w.Header().Set("Content-Type", problemContentType)
+	// Errors are often transient, so they must not stick in shared caches
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
+
+// writeError writes err as a problem response whose status and code are derived from its kind.
+// message is a client-facing summary; for non-internal kinds it is followed by the apperr message of err, never by the
+// wrapped causes, which may carry upstream URLs and dial errors. Callers log err itself.
+// Field violations are listed in invalid_params instead of the detail.
+func writeError(w http.ResponseWriter, req *http.Request, err error, message string) {
+	kind := apperr.KindOf(err)
+
+	fields := apperr.FieldsOf(err)
+
+	detail := message
+	if msg := apperr.MessageOf(err); kind != apperr.Internal && len(fields) == 0 && msg != "" {
+		detail = fmt.Sprintf("%s: %s", message, msg)
+	}
+
+	p := newProblem(req, string(kind), statusForKind(kind), problemTitles[kind], detail)
+	p.InvalidParams = fields
+	writeProblem(w, p)
+}
+
+// handleNotFound answers unknown routes with a problem response
+func handleNotFound(w http.ResponseWriter, req *http.Request) {
+	writeProblem(w, newProblem(req, string(apperr.NotFound), http.StatusNotFound, problemTitles[apperr.NotFound],
+		fmt.Sprintf("No route matches %s", req.URL.Path)))
+}
+
+// handleMethodNotAllowed answers known routes called with an unsupported method with a problem response, listing the
+// methods the route supports in the Allow header
+func handleMethodNotAllowed(w http.ResponseWriter, req *http.Request) {
+	if allowed := allowedMethods(req); len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+	}
+	writeProblem(w, newProblem(req, "method_not_allowed", http.StatusMethodNotAllowed, "Method Not Allowed",
+		fmt.Sprintf("Method %s is not allowed for %s", req.Method, req.URL.Path)))
+}
+
+// allowedMethods returns the methods registered for the path of req. chi computes them as well but only hands them to its
+// own 405 handler, so they are looked up again against the routes of the request.
+func allowedMethods(req *http.Request) []string {
+	rctx := chi.RouteContext(req.Context())
+	if rctx == nil || rctx.Routes == nil {
+		return nil
+	}
+	path := rctx.RoutePath
+	if path == "" {
+		path = req.URL.Path
+	}
+	var allowed []string
+	for _, method := range []string{
+		http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete,
+		http.MethodOptions,
+	} {
+		if rctx.Routes.Match(chi.NewRouteContext(), method, path) {
+			allowed = append(allowed, method)
+		}
+	}
+	return allowed
+}
+
+// recoverer recovers from panics in downstream handlers and answers with a problem response
+func recoverer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+			if rec == http.ErrAbortHandler {
+				panic(rec)
+			}
+
+			logger.Error(req.Context(), "Recovered from panic", zap.Any("panic", rec), zap.Stack("stacktrace"))
+			writeProblem(w, newProblem(req, string(apperr.Internal), http.StatusInternalServerError,
+				problemTitles[apperr.Internal], "An unexpected error occurred"))
+		}()
+
+		next.ServeHTTP(w, req)
+	})
+}
@@ -0,0 +1,162 @@
+package router
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mock_controller "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/controller/mock"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRouter_ProblemResponses(t *testing.T) {
+	tests := []struct {
+		name               string
+		method             string
+		path               string
+		expectedStatusCode int
+		expectedCode       string
+		expectedAllow      string
+	}{
+		{
+			name:               "Error: Unknown route returns 404 problem",
+			method:             http.MethodGet,
+			path:               "/unknown",
+			expectedStatusCode: http.StatusNotFound,
+			expectedCode:       "not_found",
+		},
+		{
+			name:               "Error: Unsupported method returns 405 problem",
+			method:             http.MethodDelete,
+			path:               "/jobs",
+			expectedStatusCode: http.StatusMethodNotAllowed,
+			expectedCode:       "method_not_allowed",
+			expectedAllow:      "GET",
+		},
+		{
+			name:               "Error: Unsupported method on a parameterized route lists its methods",
+			method:             http.MethodPost,
+			path:               "/jobs/123",
+			expectedStatusCode: http.StatusMethodNotAllowed,
+			expectedCode:       "method_not_allowed",
+			expectedAllow:      "GET",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			router := NewRouter(mock_controller.NewMockController(ctrl), AccessLogConfig{})
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatusCode, w.Code)
+			}
+			if allow := w.Header().Get("Allow"); allow != tt.expectedAllow {
+				t.Errorf("Expected Allow '%s', got '%s'", tt.expectedAllow, allow)
+			}
+			if contentType := w.Header().Get("Content-Type"); contentType != problemContentType {
+				t.Errorf("Expected Content-Type '%s', got '%s'", problemContentType, contentType)
+			}
+
+			var problem Problem
+			if err := json.NewDecoder(w.Body).Decode(&problem); err != nil {
+				t.Fatalf("Failed to decode problem: %v", err)
+			}
+			if problem.Status != tt.expectedStatusCode {
+				t.Errorf("Expected problem status %d, got %d", tt.expectedStatusCode, problem.Status)
+			}
+			if problem.Code != tt.expectedCode {
+				t.Errorf("Expected code '%s', got '%s'", tt.expectedCode, problem.Code)
+			}
+			if problem.Type != problemTypePrefix+tt.expectedCode {
+				t.Errorf("Expected type '%s', got '%s'", problemTypePrefix+tt.expectedCode, problem.Type)
+			}
+			if problem.Instance != tt.path {
+				t.Errorf("Expected instance '%s', got '%s'", tt.path, problem.Instance)
+			}
+			if problem.RequestID == "" || problem.RequestID != w.Header().Get("X-Request-Id") {
+				t.Errorf("Expected request_id to match the X-Request-Id header, got '%s'", problem.RequestID)
+			}
+		})
+	}
+}
+
+func TestRecoverer(t *testing.T) {
+	// Arrange: panicするハンドラをrecovererでラップ
+	handler := recoverer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("unexpected")
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	handler.ServeHTTP(w, req)
+
+	// Assert
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status code %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+	var problem Problem
+	if err := json.NewDecoder(w.Body).Decode(&problem); err != nil {
+		t.Fatalf("Failed to decode problem: %v", err)
+	}
+	if problem.Code != "internal" {
+		t.Errorf("Expected code 'internal', got '%s'", problem.Code)
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedDetail string
+	}{
+		{
+			name:           "Apperr message without the wrapped cause",
+			err:            apperr.Wrap(apperr.UpstreamUnavailable, errors.New(`Get "http://10.0.0.1/jobs": dial tcp 10.0.0.1:80: connection refused`), "upstream request failed"),
+			expectedDetail: "Failed to fetch jobs: upstream request failed",
+		},
+		{
+			name:           "Summary only when no apperr message is set",
+			err:            apperr.Wrap(apperr.UpstreamUnavailable, errors.New("circuit breaker is open for greenhouse"), ""),
+			expectedDetail: "Failed to fetch jobs",
+		},
+		{
+			name:           "Summary only for internal errors",
+			err:            apperr.Wrap(apperr.Internal, errors.New("disk full"), "failed to read job"),
+			expectedDetail: "Failed to fetch jobs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+			w := httptest.NewRecorder()
+
+			// Act
+			writeError(w, req, tt.err, "Failed to fetch jobs")
+
+			// Assert
+			var problem Problem
+			if err := json.NewDecoder(w.Body).Decode(&problem); err != nil {
+				t.Fatalf("Failed to decode problem: %v", err)
+			}
+			if problem.Detail != tt.expectedDetail {
+				t.Errorf("Expected detail '%s', got '%s'", tt.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package router
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+// parseJobQuery converts the /jobs query string into a JobQuery.
+// Parameters that cannot be parsed are reported as violations instead of aborting,
+// so that a single 400 response can list every offending field.
+func parseJobQuery(values url.Values) (model.JobQuery, []apperr.FieldViolation) {
+	var violations []apperr.FieldViolation
+
+	query := model.JobQuery{
+		Keyword:        strings.TrimSpace(values.Get("q")),
+		Location:       strings.TrimSpace(values.Get("location")),
+		Company:        strings.TrimSpace(values.Get("company")),
+		EmploymentType: model.EmploymentType(strings.TrimSpace(values.Get("employment_type"))),
+		Sort:           model.SortKey(strings.TrimSpace(values.Get("sort"))),
+		Order:          model.SortOrder(strings.ToLower(strings.TrimSpace(values.Get("order")))),
+		Cursor:         values.Get("cursor"),
+	}
+
+	if raw := values.Get("remote"); raw != "" {
+		remote, err := strconv.ParseBool(raw)
+		if err != nil {
+			violations = append(violations, apperr.FieldViolation{Field: "remote", Reason: "must be true or false"})
+		} else {
+			query.Remote = &remote
+		}
+	}
+
+	// prefecture accepts both "13" and "1"; JobQuery always holds the two-digit JIS code
+	if raw := values.Get("prefecture"); raw != "" {
+		code, err := strconv.Atoi(raw)
+		if err != nil {
+			violations = append(violations, apperr.FieldViolation{Field: "prefecture", Reason: "must be a prefecture code between 1 and 47"})
+		} else {
+			query.Prefecture = fmt.Sprintf("%02d", code)
+		}
+	}
+
+	if raw := values.Get("min_salary"); raw != "" {
+		minSalary, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			violations = append(violations, apperr.FieldViolation{Field: "min_salary", Reason: "must be an integer"})
+		} else {
+			query.MinSalary = minSalary
+		}
+	}
+
+	if raw := values.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit == 0 {
+			violations = append(violations, apperr.FieldViolation{Field: "limit", Reason: "must be between 1 and 100"})
+		} else {
+			query.Limit = limit
+		}
+	}
+
+	if raw := values.Get("include_total"); raw != "" {
+		includeTotal, err := strconv.ParseBool(raw)
+		if err != nil {
+			violations = append(violations, apperr.FieldViolation{Field: "include_total", Reason: "must be true or false"})
+		} else {
+			query.IncludeTotal = includeTotal
+		}
+	}
+
+	return query, violations
+}
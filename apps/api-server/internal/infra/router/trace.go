@@ -0,0 +1,112 @@
+package router
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/awslabs/aws-lambda-go-api-proxy/core"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxTraceIDLength bounds the trace IDs taken from clients, since they end up in every log line
+const maxTraceIDLength = 128
+
+// traceID gives every request a trace ID and stores it where the logger, problem responses and outbound upstream
+// requests find it. The ID is taken from X-Request-Id, then the API Gateway request ID, then the root of
+// X-Amzn-Trace-Id, and generated when none of them is usable. It is echoed in the X-Request-Id response header.
+func traceID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		id := requestTraceID(req)
+		w.Header().Set(logger.TraceIDHeader, id)
+
+		next.ServeHTTP(w, req.WithContext(logger.WithTraceID(req.Context(), id)))
+	})
+}
+
+// requestTraceID picks the trace ID of req
+func requestTraceID(req *http.Request) string {
+	if id := req.Header.Get(logger.TraceIDHeader); validTraceID(id) {
+		return id
+	}
+	if gw, ok := core.GetAPIGatewayContextFromContext(req.Context()); ok && validTraceID(gw.RequestID) {
+		return gw.RequestID
+	}
+	if root := amznTraceRoot(req.Header.Get("X-Amzn-Trace-Id")); validTraceID(root) {
+		return root
+	}
+	return newTraceID()
+}
+
+// amznTraceRoot returns the Root field of an X-Amzn-Trace-Id header such as "Root=1-5759e988-bd862e3fe1be46a994272793;Sampled=1"
+func amznTraceRoot(header string) string {
+	for _, field := range strings.Split(header, ";") {
+		if root, ok := strings.CutPrefix(strings.TrimSpace(field), "Root="); ok {
+			return root
+		}
+	}
+	return ""
+}
+
+// validTraceID reports whether id is short printable ASCII, so that a client cannot forge log fields or headers with it
+func validTraceID(id string) bool {
+	if id == "" || len(id) > maxTraceIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// newTraceID generates a random trace ID
+func newTraceID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// traceSpan wraps every request in a server span that continues the W3C trace context of the request headers.
+// The span is named after the matched chi route, such as "GET /jobs/{id}", which is only known once routing is done.
+func traceSpan(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+		ctx, span := tracing.Start(ctx, req.Method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(req.Method),
+				semconv.URLPath(req.URL.Path),
+				attribute.String("request.id", logger.TraceIDFrom(ctx)),
+			),
+		)
+		defer span.End()
+
+		ww := middleware.NewWrapResponseWriter(w, req.ProtoMajor)
+		next.ServeHTTP(ww, req.WithContext(ctx))
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		if pattern := chi.RouteContext(req.Context()).RoutePattern(); pattern != "" {
+			span.SetName(req.Method + " " + pattern)
+			span.SetAttributes(semconv.HTTPRoute(pattern))
+		}
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	})
+}
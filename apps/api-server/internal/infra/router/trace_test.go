@@ -0,0 +1,186 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/awslabs/aws-lambda-go-api-proxy/core"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	mock_controller "github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/controller/mock"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/tracing/tracingtest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTraceID(t *testing.T) {
+	tests := []struct {
+		name            string
+		headers         map[string]string
+		gatewayID       string
+		expectedTraceID string // empty means a generated ID
+	}{
+		{
+			name:            "X-Request-Id is used",
+			headers:         map[string]string{"X-Request-Id": "client-trace-1", "X-Amzn-Trace-Id": "Root=1-5759e988-bd862e3fe1be46a994272793"},
+			gatewayID:       "c6af9ac6-7b61-11e6-9a41-93e8deadbeef",
+			expectedTraceID: "client-trace-1",
+		},
+		{
+			name:            "API Gateway request ID is used without X-Request-Id",
+			headers:         map[string]string{"X-Amzn-Trace-Id": "Root=1-5759e988-bd862e3fe1be46a994272793"},
+			gatewayID:       "c6af9ac6-7b61-11e6-9a41-93e8deadbeef",
+			expectedTraceID: "c6af9ac6-7b61-11e6-9a41-93e8deadbeef",
+		},
+		{
+			name:            "Root of X-Amzn-Trace-Id is used outside API Gateway",
+			headers:         map[string]string{"X-Amzn-Trace-Id": "Self=1-67891234-12456789abcdef012345678;Root=1-5759e988-bd862e3fe1be46a994272793;Sampled=1"},
+			expectedTraceID: "1-5759e988-bd862e3fe1be46a994272793",
+		},
+		{
+			name: "ID is generated without any header",
+		},
+		{
+			name:    "Unprintable X-Request-Id is replaced",
+			headers: map[string]string{"X-Request-Id": "bad id\t{}"},
+		},
+		{
+			name:    "Too long X-Request-Id is replaced",
+			headers: map[string]string{"X-Request-Id": strings.Repeat("a", maxTraceIDLength+1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var seen string
+			handler := traceID(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				seen = logger.TraceIDFrom(req.Context())
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			if tt.gatewayID != "" {
+				// chiadapter が API Gateway のリクエストコンテキストを ctx に格納する
+				apiReq := events.APIGatewayProxyRequest{HTTPMethod: http.MethodGet, Path: "/jobs", RequestContext: events.APIGatewayProxyRequestContext{RequestID: tt.gatewayID}}
+				gwReq, err := (&core.RequestAccessor{}).EventToRequestWithContext(context.Background(), apiReq)
+				if err != nil {
+					t.Fatalf("Failed to build API Gateway request: %v", err)
+				}
+				req = req.WithContext(gwReq.Context())
+			}
+			w := httptest.NewRecorder()
+
+			// Act
+			handler.ServeHTTP(w, req)
+
+			// Assert
+			if seen == "" || w.Header().Get("X-Request-Id") != seen {
+				t.Fatalf("Expected the trace ID '%s' to be echoed, got '%s'", seen, w.Header().Get("X-Request-Id"))
+			}
+			if tt.expectedTraceID != "" && seen != tt.expectedTraceID {
+				t.Errorf("Expected trace ID '%s', got '%s'", tt.expectedTraceID, seen)
+			}
+			if tt.expectedTraceID == "" && len(seen) != 32 {
+				t.Errorf("Expected a generated trace ID, got '%s'", seen)
+			}
+		})
+	}
+}
+
+func TestTraceSpan(t *testing.T) {
+	const parentTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	tests := []struct {
+		name               string
+		path               string
+		traceparent        string
+		mockSetup          func(m *mock_controller.MockController)
+		expectedName       string
+		expectedStatusCode int64
+		expectedStatus     codes.Code
+	}{
+		{
+			name:        "Span is named after the route and continues the caller's trace",
+			path:        "/jobs/1",
+			traceparent: "00-" + parentTraceID + "-00f067aa0ba902b7-01",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetJob(gomock.Any(), "1").Return(&model.Job{ID: "1"}, nil)
+			},
+			expectedName:       "GET /jobs/{id}",
+			expectedStatusCode: http.StatusOK,
+			expectedStatus:     codes.Unset,
+		},
+		{
+			name: "5xx marks the span as failed",
+			path: "/jobs",
+			mockSetup: func(m *mock_controller.MockController) {
+				m.EXPECT().GetJobs(gomock.Any(), gomock.Any()).Return(nil, apperr.New(apperr.UpstreamUnavailable, "upstream returned status 503"))
+			},
+			expectedName:       "GET /jobs",
+			expectedStatusCode: http.StatusBadGateway,
+			expectedStatus:     codes.Error,
+		},
+		{
+			name:               "4xx does not mark the span as failed",
+			path:               "/unknown",
+			mockSetup:          func(m *mock_controller.MockController) {},
+			expectedName:       "GET",
+			expectedStatusCode: http.StatusNotFound,
+			expectedStatus:     codes.Unset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			exporter := tracingtest.Setup(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockController := mock_controller.NewMockController(ctrl)
+			tt.mockSetup(mockController)
+			router := NewRouter(mockController, AccessLogConfig{})
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+
+			// Act
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			// Assert
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("Expected 1 span, got %d", len(spans))
+			}
+			span := spans[0]
+			if span.Name != tt.expectedName || span.SpanKind != trace.SpanKindServer {
+				t.Errorf("Expected server span '%s', got %s span '%s'", tt.expectedName, span.SpanKind, span.Name)
+			}
+			if tt.traceparent != "" && (span.SpanContext.TraceID().String() != parentTraceID || !span.Parent.IsRemote()) {
+				t.Errorf("Expected a child of the remote trace %s, got %s", parentTraceID, span.SpanContext.TraceID())
+			}
+			if tt.traceparent == "" && span.Parent.IsValid() {
+				t.Errorf("Expected a root span, got parent %s", span.Parent.SpanID())
+			}
+			attrs := attribute.NewSet(span.Attributes...)
+			if status, _ := attrs.Value("http.response.status_code"); status.AsInt64() != tt.expectedStatusCode {
+				t.Errorf("Expected status code attribute %d, got %v", tt.expectedStatusCode, status.Emit())
+			}
+			if requestID, _ := attrs.Value("request.id"); requestID.AsString() == "" {
+				t.Error("Expected the request ID attribute to be set")
+			}
+			if span.Status.Code != tt.expectedStatus {
+				t.Errorf("Expected span status %v, got %v", tt.expectedStatus, span.Status)
+			}
+		})
+	}
+}
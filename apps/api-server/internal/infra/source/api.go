@@ -0,0 +1,18 @@
+package source
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+)
+
+// newAPI creates a source for an upstream that already serves model.Job JSON, like API_ENDPOINT.
+// Its job IDs are used as they are, so that existing job URLs keep working.
+func newAPI(spec Spec, client *http.Client) (ingest.Source, error) {
+	if spec.Endpoint == "" {
+		return nil, errors.New("api source requires an endpoint")
+	}
+	return ingest.NamedSource(spec.Name, &httpclient.ClientImpl{Endpoint: spec.Endpoint, HTTPClient: client}), nil
+}
@@ -0,0 +1,318 @@
+package source
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"go.uber.org/zap"
+)
+
+// maxFeedSize limits how much of a feed is read
+const maxFeedSize = 10 << 20
+
+// Feed reads an RSS 2.0, Atom 1.0 or JSON Feed 1.x career feed.
+// It remembers the ETag and Last-Modified of the last response, so polling an unchanged feed costs a 304.
+// Feeds have no single-item endpoint, so GetJob looks the job up in the feed.
+type Feed struct {
+	base
+	endpoint string
+	company  string
+
+	mu           sync.Mutex // guards the fields below, not the fetch
+	etag         string
+	lastModified string
+	jobs         []model.Job // jobs of the last 200 response
+}
+
+func newFeed(spec Spec, client *http.Client) (ingest.Source, error) {
+	if spec.Endpoint == "" {
+		return nil, errors.New("feed source requires an endpoint")
+	}
+	return &Feed{
+		base:     base{name: spec.Name, client: client},
+		endpoint: spec.Endpoint,
+		company:  spec.Company,
+	}, nil
+}
+
+// GetJobs fetches the feed, or returns the jobs of the previous fetch when the feed has not been modified
+func (f *Feed) GetJobs(ctx context.Context) ([]model.Job, error) {
+	f.mu.Lock()
+	cached, etag, lastModified := f.jobs, f.etag, f.lastModified
+	f.mu.Unlock()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.endpoint, nil)
+	if err != nil {
+		return nil, apperr.Wrap(apperr.Internal, err, "failed to build upstream request")
+	}
+	req.Header.Set("Accept", "application/feed+json, application/atom+xml, application/rss+xml, application/xml;q=0.9, */*;q=0.8")
+	if cached != nil {
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	logger.Debug(ctx, "Fetching jobs from feed", zap.String("source", f.name), zap.String("endpoint", f.endpoint))
+	resp, err := httpclient.Do(f.client, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		logger.Debug(ctx, "Feed not modified", zap.String("source", f.name))
+		return cloneJobs(cached), nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxFeedSize))
+	if err != nil {
+		return nil, httpclient.BodyError(ctx, err)
+	}
+	jobs, err := f.parse(body)
+	if err != nil {
+		return nil, httpclient.BodyError(ctx, err)
+	}
+
+	f.mu.Lock()
+	f.jobs, f.etag, f.lastModified = jobs, resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	f.mu.Unlock()
+	return cloneJobs(jobs), nil
+}
+
+// GetJob finds a single job in the feed
+func (f *Feed) GetJob(ctx context.Context, id string) (*model.Job, error) {
+	if _, err := f.upstreamID(id); err != nil {
+		return nil, err
+	}
+	jobs, err := f.GetJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return findJob(jobs, id)
+}
+
+// parse detects the feed format from the body and converts its items to jobs
+func (f *Feed) parse(body []byte) ([]model.Job, error) {
+	body = bytes.TrimSpace(body)
+	if bytes.HasPrefix(body, []byte("{")) {
+		return f.parseJSONFeed(body)
+	}
+
+	var root struct {
+		XMLName xml.Name
+	}
+	if err := xml.Unmarshal(body, &root); err != nil {
+		return nil, err
+	}
+	switch root.XMLName.Local {
+	case "rss":
+		return f.parseRSS(body)
+	case "feed":
+		return f.parseAtom(body)
+	}
+	return nil, fmt.Errorf("unsupported feed root element <%s>", root.XMLName.Local)
+}
+
+type rssFeed struct {
+	Channel struct {
+		Title string `xml:"title"`
+		Items []struct {
+			Title       string `xml:"title"`
+			Link        string `xml:"link"`
+			GUID        string `xml:"guid"`
+			PubDate     string `xml:"pubDate"`
+			Description string `xml:"description"`
+			Creator     string `xml:"http://purl.org/dc/elements/1.1/ creator"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+func (f *Feed) parseRSS(body []byte) ([]model.Job, error) {
+	var feed rssFeed
+	if err := xml.Unmarshal(body, &feed); err != nil {
+		return nil, err
+	}
+	jobs := make([]model.Job, 0, len(feed.Channel.Items))
+	for _, item := range feed.Channel.Items {
+		jobs = append(jobs, f.toJob(feedItem{
+			id:          firstNonEmpty(item.GUID, item.Link, item.Title),
+			title:       item.Title,
+			author:      item.Creator,
+			link:        item.Link,
+			description: item.Description,
+			published:   parseFeedTime(item.PubDate),
+		}, feed.Channel.Title))
+	}
+	return jobs, nil
+}
+
+type atomFeed struct {
+	Title   string `xml:"title"`
+	Entries []struct {
+		ID        string `xml:"id"`
+		Title     string `xml:"title"`
+		Published string `xml:"published"`
+		Updated   string `xml:"updated"`
+		Summary   string `xml:"summary"`
+		Content   string `xml:"content"`
+		Author    struct {
+			Name string `xml:"name"`
+		} `xml:"author"`
+		Links []struct {
+			Href string `xml:"href,attr"`
+			Rel  string `xml:"rel,attr"`
+		} `xml:"link"`
+	} `xml:"entry"`
+}
+
+func (f *Feed) parseAtom(body []byte) ([]model.Job, error) {
+	var feed atomFeed
+	if err := xml.Unmarshal(body, &feed); err != nil {
+		return nil, err
+	}
+	jobs := make([]model.Job, 0, len(feed.Entries))
+	for _, entry := range feed.Entries {
+		// The alternate link is the posting; a link without rel is alternate too
+		var link string
+		for _, l := range entry.Links {
+			if l.Rel == "" || l.Rel == "alternate" {
+				link = l.Href
+				break
+			}
+		}
+		jobs = append(jobs, f.toJob(feedItem{
+			id:          firstNonEmpty(entry.ID, link, entry.Title),
+			title:       entry.Title,
+			author:      entry.Author.Name,
+			link:        link,
+			description: firstNonEmpty(entry.Content, entry.Summary),
+			published:   parseFeedTime(firstNonEmpty(entry.Published, entry.Updated)),
+		}, feed.Title))
+	}
+	return jobs, nil
+}
+
+type jsonFeed struct {
+	Version string `json:"version"`
+	Title   string `json:"title"`
+	Items   []struct {
+		ID            string `json:"id"`
+		URL           string `json:"url"`
+		ExternalURL   string `json:"external_url"`
+		Title         string `json:"title"`
+		ContentHTML   string `json:"content_html"`
+		ContentText   string `json:"content_text"`
+		Summary       string `json:"summary"`
+		DatePublished string `json:"date_published"`
+		DateModified  string `json:"date_modified"`
+		Authors       []struct {
+			Name string `json:"name"`
+		} `json:"authors"`
+		Author *struct {
+			Name string `json:"name"`
+		} `json:"author"` // JSON Feed 1.0
+	} `json:"items"`
+}
+
+func (f *Feed) parseJSONFeed(body []byte) ([]model.Job, error) {
+	var feed jsonFeed
+	if err := json.Unmarshal(body, &feed); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(feed.Version, "https://jsonfeed.org/version/") {
+		return nil, fmt.Errorf("unsupported JSON Feed version %q", feed.Version)
+	}
+	jobs := make([]model.Job, 0, len(feed.Items))
+	for _, item := range feed.Items {
+		var author string
+		if len(item.Authors) > 0 {
+			author = item.Authors[0].Name
+		} else if item.Author != nil {
+			author = item.Author.Name
+		}
+		jobs = append(jobs, f.toJob(feedItem{
+			id:          item.ID,
+			title:       item.Title,
+			author:      author,
+			link:        firstNonEmpty(item.ExternalURL, item.URL),
+			description: firstNonEmpty(item.ContentHTML, item.ContentText, item.Summary),
+			published:   parseFeedTime(firstNonEmpty(item.DatePublished, item.DateModified)),
+		}, feed.Title))
+	}
+	return jobs, nil
+}
+
+// feedItem is an item of any of the feed formats
+type feedItem struct {
+	id          string
+	title       string
+	author      string
+	link        string
+	description string
+	published   time.Time
+}
+
+// toJob converts a feed item. The company is the configured one, else the item's author, else the feed title.
+func (f *Feed) toJob(item feedItem, feedTitle string) model.Job {
+	// Item IDs are often URLs, which cannot be part of a /jobs/{id} path, so they are hashed
+	sum := sha256.Sum256([]byte(item.id))
+	return model.Job{
+		ID:          f.jobID(hex.EncodeToString(sum[:8])),
+		Title:       strings.TrimSpace(item.title),
+		Company:     strings.TrimSpace(firstNonEmpty(f.company, item.author, feedTitle)),
+		Description: plainText(item.description),
+		ApplyURL:    strings.TrimSpace(item.link),
+		PostedAt:    item.published,
+	}
+}
+
+// feedTimeLayouts are the date formats seen in feeds: RFC 822 variants for RSS, RFC 3339 for Atom and JSON Feed
+var feedTimeLayouts = []string{time.RFC3339, time.RFC1123Z, time.RFC1123, "Mon, 2 Jan 2006 15:04:05 -0700", "Mon, 2 Jan 2006 15:04:05 MST", "2 Jan 2006 15:04:05 -0700", time.RFC822Z, time.RFC822}
+
+// parseFeedTime parses a feed date; unknown formats are the zero time
+func parseFeedTime(s string) time.Time {
+	s = strings.TrimSpace(s)
+	for _, layout := range feedTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// cloneJobs copies jobs, so that callers cannot modify the cached feed
+func cloneJobs(jobs []model.Job) []model.Job {
+	clones := make([]model.Job, len(jobs))
+	for i, job := range jobs {
+		clones[i] = job.Clone()
+	}
+	return clones
+}
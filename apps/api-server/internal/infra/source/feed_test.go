@@ -0,0 +1,273 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+func TestFeed_GetJobs(t *testing.T) {
+	tests := []struct {
+		name         string
+		fixture      string
+		company      string
+		expectedJobs []model.Job
+	}{
+		{
+			name:    "Success: RSS 2.0 items use the author or the channel title as company",
+			fixture: "feed_rss.xml",
+			expectedJobs: []model.Job{
+				{
+					ID:          "mirai:8a574dc811a5e650",
+					Title:       "Backend Engineer (Go)",
+					Company:     "Mirai Labs Careers",
+					Description: "Build our APIs in Go.\n勤務地: 東京都港区",
+					ApplyURL:    "https://mirai.example/careers/backend-engineer",
+					PostedAt:    time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:          "mirai:e3431d584c4979fe",
+					Title:       "Mobile Engineer",
+					Company:     "Mirai Mobile",
+					Description: "Flutter & Swift",
+					ApplyURL:    "https://mirai.example/careers/mobile-engineer",
+					PostedAt:    time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name:    "Success: Atom 1.0 entries use the alternate link and fall back to updated",
+			fixture: "feed_atom.xml",
+			expectedJobs: []model.Job{
+				{
+					ID:          "mirai:93b3fd5f416dada1",
+					Title:       "インフラエンジニア",
+					Company:     "Hoshi Systems 採用情報",
+					Description: "AWS と Terraform によるインフラ構築\n年収700万円〜",
+					ApplyURL:    "https://hoshi.example/jobs/301",
+					PostedAt:    time.Date(2026, 3, 5, 1, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:          "mirai:3fd6b7664fa88f5f",
+					Title:       "データエンジニア",
+					Company:     "Hoshi Data",
+					Description: "BigQuery / dbt",
+					ApplyURL:    "https://hoshi.example/jobs/302",
+					PostedAt:    time.Date(2026, 3, 6, 3, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name:    "Success: JSON Feed items prefer the external URL and the configured company",
+			fixture: "feed.json",
+			company: "Kumo",
+			expectedJobs: []model.Job{
+				{
+					ID:          "mirai:f8c6b424f156d0ba",
+					Title:       "Frontend Engineer",
+					Company:     "Kumo",
+					Description: "React and TypeScript.",
+					ApplyURL:    "https://kumo.example/jobs/frontend",
+					PostedAt:    time.Date(2026, 3, 7, 8, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:          "mirai:10619492a52d2dd7",
+					Title:       "ML Engineer",
+					Company:     "Kumo",
+					Description: "PyTorch, LLM",
+					ApplyURL:    "https://apply.kumo.example/ml",
+					PostedAt:    time.Date(2026, 3, 8, 8, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			server, _ := serveFixtures(t, map[string]string{"/careers": tt.fixture})
+			src := buildSource(t, Spec{Name: "mirai", Type: "feed", Endpoint: server.URL + "/careers", Company: tt.company})
+
+			// Act
+			jobs, err := src.GetJobs(context.Background())
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			assertJobs(t, jobs, tt.expectedJobs)
+		})
+	}
+}
+
+func TestFeed_GetJobs_Malformed(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "Error: Not XML or JSON", body: "<html><body>Careers</body>"},
+		{name: "Error: Unknown XML root", body: `<?xml version="1.0"?><opml version="2.0"></opml>`},
+		{name: "Error: JSON that is not a JSON Feed", body: `{"jobs":[]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+			src := buildSource(t, Spec{Name: "mirai", Type: "feed", Endpoint: server.URL})
+
+			// Act
+			_, err := src.GetJobs(context.Background())
+
+			// Assert
+			if !errors.Is(err, httpclient.ErrMalformedResponse) || apperr.KindOf(err) != apperr.UpstreamUnavailable {
+				t.Errorf("Expected malformed response as upstream_unavailable, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFeed_GetJobs_ConditionalRequests(t *testing.T) {
+	tests := []struct {
+		name            string
+		header          string
+		value           string
+		conditionHeader string
+	}{
+		{name: "Success: ETag is sent back as If-None-Match", header: "ETag", value: `"v1"`, conditionHeader: "If-None-Match"},
+		{name: "Success: Last-Modified is sent back as If-Modified-Since", header: "Last-Modified", value: "Mon, 02 Mar 2026 00:00:00 GMT", conditionHeader: "If-Modified-Since"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange: 2回目以降は条件付きリクエストに304を返すフィード
+			body, err := os.ReadFile(filepath.Join("testdata", "feed_rss.xml"))
+			if err != nil {
+				t.Fatalf("Failed to read fixture: %v", err)
+			}
+			var conditions []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				conditions = append(conditions, r.Header.Get(tt.conditionHeader))
+				if r.Header.Get(tt.conditionHeader) == tt.value {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				w.Header().Set(tt.header, tt.value)
+				w.Write(body)
+			}))
+			defer server.Close()
+			src := buildSource(t, Spec{Name: "mirai", Type: "feed", Endpoint: server.URL})
+
+			// Act
+			first, err := src.GetJobs(context.Background())
+			if err != nil {
+				t.Fatalf("Expected no error on first poll, got %v", err)
+			}
+			first[0].Title = "modified by the caller"
+			second, err := src.GetJobs(context.Background())
+
+			// Assert: 304の場合は前回のJobが返り、呼び出し側の変更は影響しない
+			if err != nil {
+				t.Fatalf("Expected no error on second poll, got %v", err)
+			}
+			if len(conditions) != 2 || conditions[0] != "" || conditions[1] != tt.value {
+				t.Errorf("Expected conditions [\"\" %q], got %q", tt.value, conditions)
+			}
+			if len(second) != 2 || second[0].Title != "Backend Engineer (Go)" {
+				t.Errorf("Expected the cached jobs, got %+v", second)
+			}
+		})
+	}
+}
+
+func TestFeed_GetJobs_Concurrent(t *testing.T) {
+	// Arrange: 最初のリクエストは2つ目のリクエストが届くまで応答しない
+	body, err := os.ReadFile(filepath.Join("testdata", "feed_rss.xml"))
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+	second := make(chan struct{})
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			select {
+			case <-second:
+			case <-time.After(5 * time.Second):
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+		} else {
+			close(second)
+		}
+		w.Write(body)
+	}))
+	defer server.Close()
+	src := buildSource(t, Spec{Name: "mirai", Type: "feed", Endpoint: server.URL})
+
+	// Act
+	errs := make(chan error, 2)
+	for range 2 {
+		go func() {
+			_, err := src.GetJobs(context.Background())
+			errs <- err
+		}()
+	}
+
+	// Assert: 取得中もロックを保持しないため、もう一方の取得が上流に届く
+	for range 2 {
+		if err := <-errs; err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	}
+}
+
+func TestFeed_GetJob(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		expectedTitle string
+		expectedKind  apperr.Kind
+	}{
+		{name: "Success: Job is found in the feed", id: "mirai:e3431d584c4979fe", expectedTitle: "Mobile Engineer"},
+		{name: "Error: Job missing from the feed is NotFound", id: "mirai:0000000000000000", expectedKind: apperr.NotFound},
+		{name: "Error: Job of another source is NotFound", id: "kumo:e3431d584c4979fe", expectedKind: apperr.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			server, _ := serveFixtures(t, map[string]string{"/careers.rss": "feed_rss.xml"})
+			src := buildSource(t, Spec{Name: "mirai", Type: "feed", Endpoint: server.URL + "/careers.rss"})
+
+			// Act
+			job, err := src.GetJob(context.Background(), tt.id)
+
+			// Assert
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if job.ID != tt.id || job.Title != tt.expectedTitle {
+				t.Errorf("Unexpected job %+v", job)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package source
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"go.uber.org/zap"
+)
+
+// Mapping tells the generic adapter where the job fields are in an arbitrary JSON payload.
+// Fields are dot-separated paths such as "salary.text"; numeric segments index arrays ("locations.0.name").
+type Mapping struct {
+	Jobs   string `json:"jobs,omitempty"`    // path of the job array. Empty when the body is the array
+	JobURL string `json:"job_url,omitempty"` // URL of a single job with an {id} placeholder. Empty to search the list
+
+	ID             string `json:"id"`
+	Title          string `json:"title"`
+	Company        string `json:"company,omitempty"`
+	Location       string `json:"location,omitempty"`
+	Description    string `json:"description,omitempty"` // HTML is converted to plain text
+	EmploymentType string `json:"employment_type,omitempty"`
+	RemotePolicy   string `json:"remote_policy,omitempty"`
+	SalaryText     string `json:"salary_text,omitempty"`
+	ApplyURL       string `json:"apply_url,omitempty"`
+	PostedAt       string `json:"posted_at,omitempty"` // RFC 3339, YYYY-MM-DD or Unix seconds
+}
+
+// Generic reads any JSON job feed described by a Mapping
+type Generic struct {
+	base
+	endpoint string
+	company  string
+	mapping  Mapping
+}
+
+func newGeneric(spec Spec, client *http.Client) (ingest.Source, error) {
+	if spec.Endpoint == "" {
+		return nil, errors.New("generic source requires an endpoint")
+	}
+	if spec.Mapping == nil || spec.Mapping.ID == "" || spec.Mapping.Title == "" {
+		return nil, errors.New("generic source requires a mapping with at least id and title")
+	}
+	return &Generic{
+		base:     base{name: spec.Name, client: client},
+		endpoint: spec.Endpoint,
+		company:  spec.Company,
+		mapping:  *spec.Mapping,
+	}, nil
+}
+
+// GetJobs fetches the feed and maps every element of the job array
+func (g *Generic) GetJobs(ctx context.Context) ([]model.Job, error) {
+	logger.Debug(ctx, "Fetching jobs from generic JSON source", zap.String("source", g.name), zap.String("endpoint", g.endpoint))
+
+	var payload any
+	if err := g.getJSON(ctx, g.endpoint, &payload); err != nil {
+		return nil, err
+	}
+
+	items, ok := lookup(payload, g.mapping.Jobs).([]any)
+	if !ok {
+		return nil, apperr.Wrap(apperr.UpstreamUnavailable,
+			fmt.Errorf("%w: no job array at %q", httpclient.ErrMalformedResponse, g.mapping.Jobs), "")
+	}
+	jobs := make([]model.Job, 0, len(items))
+	for _, item := range items {
+		jobs = append(jobs, g.toJob(item))
+	}
+	return jobs, nil
+}
+
+// GetJob fetches a single job from the job URL, or searches the feed when there is none
+func (g *Generic) GetJob(ctx context.Context, id string) (*model.Job, error) {
+	upstreamID, err := g.upstreamID(id)
+	if err != nil {
+		return nil, err
+	}
+	if g.mapping.JobURL == "" {
+		jobs, err := g.GetJobs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return findJob(jobs, id)
+	}
+
+	jobURL := strings.ReplaceAll(g.mapping.JobURL, "{id}", url.PathEscape(upstreamID))
+	logger.Debug(ctx, "Fetching job from generic JSON source", zap.String("source", g.name), zap.String("endpoint", jobURL))
+
+	var payload any
+	if err := g.getJSON(ctx, jobURL, &payload); err != nil {
+		return nil, httpclient.JobNotFound(err, id)
+	}
+	job := g.toJob(payload)
+	return &job, nil
+}
+
+// getJSON decodes numbers as json.Number, so that large numeric IDs keep every digit
+func (g *Generic) getJSON(ctx context.Context, rawURL string, v *any) error {
+	var raw json.RawMessage
+	if err := httpclient.GetJSON(ctx, g.client, rawURL, &raw); err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func (g *Generic) toJob(item any) model.Job {
+	field := func(path string) string {
+		if path == "" {
+			return ""
+		}
+		return stringValue(lookup(item, path))
+	}
+
+	job := model.Job{
+		ID:             g.jobID(field(g.mapping.ID)),
+		Title:          field(g.mapping.Title),
+		Company:        field(g.mapping.Company),
+		Location:       field(g.mapping.Location),
+		Description:    plainText(field(g.mapping.Description)),
+		EmploymentType: employmentType(field(g.mapping.EmploymentType)),
+		RemotePolicy:   remotePolicy(field(g.mapping.RemotePolicy)),
+		SalaryText:     field(g.mapping.SalaryText),
+		ApplyURL:       field(g.mapping.ApplyURL),
+		PostedAt:       parseTime(field(g.mapping.PostedAt)),
+	}
+	if job.Company == "" {
+		job.Company = g.company
+	}
+	return job
+}
+
+// lookup follows a dot-separated path through decoded JSON. It returns nil when the path does not exist.
+func lookup(v any, path string) any {
+	if path == "" {
+		return v
+	}
+	for _, key := range strings.Split(path, ".") {
+		switch node := v.(type) {
+		case map[string]any:
+			v = node[key]
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil
+			}
+			v = node[i]
+		default:
+			return nil
+		}
+	}
+	return v
+}
+
+// stringValue formats a JSON scalar as a string; objects and arrays become ""
+func stringValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
+
+// parseTime accepts RFC 3339 timestamps, dates and Unix seconds; anything else is the zero time
+func parseTime(s string) time.Time {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t
+	}
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t
+	}
+	if sec, err := strconv.ParseInt(s, 10, 64); err == nil && sec > 0 {
+		return time.Unix(sec, 0).UTC()
+	}
+	return time.Time{}
+}
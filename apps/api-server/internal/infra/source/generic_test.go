@@ -0,0 +1,140 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+// hikariMapping maps testdata/generic_jobs.json
+var hikariMapping = Mapping{
+	Jobs:           "data.postings",
+	ID:             "posting_id",
+	Title:          "name",
+	Company:        "org.name",
+	Location:       "offices.0.label",
+	Description:    "body",
+	EmploymentType: "contract",
+	SalaryText:     "pay",
+	ApplyURL:       "link",
+	PostedAt:       "published",
+}
+
+func TestGeneric_GetJobs(t *testing.T) {
+	tests := []struct {
+		name         string
+		mapping      Mapping
+		expectedJobs []model.Job
+		expectedErr  error
+	}{
+		{
+			name:    "Success: Fields are mapped by path",
+			mapping: hikariMapping,
+			expectedJobs: []model.Job{
+				{
+					ID:             "hikari:90071992547409931",
+					Title:          "SRE",
+					Company:        "Hikari Cloud",
+					Location:       "Sapporo, Hokkaido",
+					Description:    "Operate our & customers' clusters.",
+					EmploymentType: model.EmploymentFullTime,
+					SalaryText:     "月給50万円〜70万円",
+					ApplyURL:       "https://careers.hikari.example/jobs/90071992547409931",
+					PostedAt:       time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:             "hikari:42",
+					Title:          "Intern - Machine Learning",
+					Company:        "Hikari Cloud",
+					EmploymentType: model.EmploymentInternship,
+					PostedAt:       time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name:        "Error: Job array path does not exist",
+			mapping:     Mapping{Jobs: "data.jobs", ID: "posting_id", Title: "name"},
+			expectedErr: httpclient.ErrMalformedResponse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			server, _ := serveFixtures(t, map[string]string{"/jobs.json": "generic_jobs.json"})
+			mapping := tt.mapping
+			src := buildSource(t, Spec{Name: "hikari", Type: "generic", Endpoint: server.URL + "/jobs.json", Mapping: &mapping})
+
+			// Act
+			jobs, err := src.GetJobs(context.Background())
+
+			// Assert
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) || apperr.KindOf(err) != apperr.UpstreamUnavailable {
+					t.Fatalf("Expected %v as upstream_unavailable, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			assertJobs(t, jobs, tt.expectedJobs)
+		})
+	}
+}
+
+func TestGeneric_GetJob(t *testing.T) {
+	tests := []struct {
+		name             string
+		jobURL           string
+		id               string
+		expectedTitle    string
+		expectedKind     apperr.Kind
+		expectedRequests int
+	}{
+		{name: "Success: Job is fetched from the job URL", jobURL: "/jobs/{id}.json", id: "hikari:42", expectedTitle: "Intern - Machine Learning", expectedRequests: 1},
+		{name: "Success: Job is found in the feed without a job URL", id: "hikari:42", expectedTitle: "Intern - Machine Learning", expectedRequests: 1},
+		{name: "Error: Upstream 404 is NotFound", jobURL: "/jobs/{id}.json", id: "hikari:7", expectedKind: apperr.NotFound, expectedRequests: 1},
+		{name: "Error: Job missing from the feed is NotFound", id: "hikari:7", expectedKind: apperr.NotFound, expectedRequests: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			server, requests := serveFixtures(t, map[string]string{
+				"/jobs.json":    "generic_jobs.json",
+				"/jobs/42.json": "generic_job.json",
+			})
+			mapping := hikariMapping
+			if tt.jobURL != "" {
+				mapping.JobURL = server.URL + tt.jobURL
+			}
+			src := buildSource(t, Spec{Name: "hikari", Type: "generic", Endpoint: server.URL + "/jobs.json", Mapping: &mapping})
+
+			// Act
+			job, err := src.GetJob(context.Background(), tt.id)
+
+			// Assert
+			if *requests != tt.expectedRequests {
+				t.Errorf("Expected %d requests, got %d", tt.expectedRequests, *requests)
+			}
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if job.ID != tt.id || job.Title != tt.expectedTitle || job.Company != "Hikari Cloud" {
+				t.Errorf("Unexpected job %+v", job)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"go.uber.org/zap"
+)
+
+// Greenhouse reads a job board of the Greenhouse Job Board API
+// (https://developers.greenhouse.io/job-board.html)
+type Greenhouse struct {
+	base
+	endpoint string
+	board    string
+	company  string
+}
+
+func newGreenhouse(spec Spec, client *http.Client) (ingest.Source, error) {
+	if err := requireBoard(spec); err != nil {
+		return nil, err
+	}
+	return &Greenhouse{
+		base:     base{name: spec.Name, client: client},
+		endpoint: endpointOr(spec, "https://boards-api.greenhouse.io"),
+		board:    spec.Board,
+		company:  companyOr(spec, spec.Board),
+	}, nil
+}
+
+type greenhouseJob struct {
+	ID             int64     `json:"id"`
+	Title          string    `json:"title"`
+	FirstPublished time.Time `json:"first_published"` // posting date; updated_at changes with every edit
+	AbsoluteURL    string    `json:"absolute_url"`
+	Content        string    `json:"content"` // HTML-escaped HTML
+	Location       struct {
+		Name string `json:"name"`
+	} `json:"location"`
+	Metadata []struct {
+		Name  string `json:"name"`
+		Value any    `json:"value"`
+	} `json:"metadata"`
+}
+
+// GetJobs fetches every job of the board, including the descriptions
+func (g *Greenhouse) GetJobs(ctx context.Context) ([]model.Job, error) {
+	jobsURL := fmt.Sprintf("%s/v1/boards/%s/jobs?content=true", g.endpoint, url.PathEscape(g.board))
+	logger.Debug(ctx, "Fetching jobs from Greenhouse", zap.String("source", g.name), zap.String("endpoint", jobsURL))
+
+	var payload struct {
+		Jobs []greenhouseJob `json:"jobs"`
+	}
+	if err := httpclient.GetJSON(ctx, g.client, jobsURL, &payload); err != nil {
+		return nil, err
+	}
+
+	jobs := make([]model.Job, 0, len(payload.Jobs))
+	for _, j := range payload.Jobs {
+		jobs = append(jobs, g.toJob(j))
+	}
+	return jobs, nil
+}
+
+// GetJob fetches a single job of the board
+func (g *Greenhouse) GetJob(ctx context.Context, id string) (*model.Job, error) {
+	upstreamID, err := g.upstreamID(id)
+	if err != nil {
+		return nil, err
+	}
+	jobURL := fmt.Sprintf("%s/v1/boards/%s/jobs/%s", g.endpoint, url.PathEscape(g.board), url.PathEscape(upstreamID))
+	logger.Debug(ctx, "Fetching job from Greenhouse", zap.String("source", g.name), zap.String("endpoint", jobURL))
+
+	var payload greenhouseJob
+	if err := httpclient.GetJSON(ctx, g.client, jobURL, &payload); err != nil {
+		return nil, httpclient.JobNotFound(err, id)
+	}
+	job := g.toJob(payload)
+	return &job, nil
+}
+
+func (g *Greenhouse) toJob(j greenhouseJob) model.Job {
+	job := model.Job{
+		ID:          g.jobID(strconv.FormatInt(j.ID, 10)),
+		Title:       j.Title,
+		Company:     g.company,
+		Location:    j.Location.Name,
+		Description: plainText(j.Content),
+		ApplyURL:    j.AbsoluteURL,
+		PostedAt:    j.FirstPublished,
+	}
+	// Boards commonly expose the employment type as a custom metadata field
+	for _, m := range j.Metadata {
+		if value, ok := m.Value.(string); ok && employmentType(value) != "" {
+			job.EmploymentType = employmentType(value)
+			break
+		}
+	}
+	return job
+}
@@ -0,0 +1,92 @@
+package source
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+func TestGreenhouse_GetJobs(t *testing.T) {
+	// Arrange
+	server, _ := serveFixtures(t, map[string]string{
+		"/v1/boards/acmejapan/jobs?content=true": "greenhouse_jobs.json",
+	})
+	src := buildSource(t, Spec{Type: "greenhouse", Board: "acmejapan", Company: "Acme Japan", Endpoint: server.URL})
+
+	// Act
+	jobs, err := src.GetJobs(context.Background())
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	expected := []model.Job{
+		{
+			ID:             "greenhouse-acmejapan:4012345",
+			Title:          "Senior Backend Engineer (Go)",
+			Company:        "Acme Japan",
+			Location:       "Tokyo, Japan",
+			Description:    "We are looking for a Go engineer.\n5+ years of experience\nKubernetes\nSalary: 年収800万円〜1200万円 & stock options",
+			EmploymentType: model.EmploymentFullTime,
+			ApplyURL:       "https://boards.greenhouse.io/acmejapan/jobs/4012345",
+			PostedAt:       time.Date(2026, 2, 16, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			ID:          "greenhouse-acmejapan:4012346",
+			Title:       "QA Engineer",
+			Company:     "Acme Japan",
+			Location:    "Osaka",
+			Description: "Manual and automated testing.",
+			ApplyURL:    "https://boards.greenhouse.io/acmejapan/jobs/4012346",
+			PostedAt:    time.Date(2026, 2, 20, 1, 0, 0, 0, time.UTC),
+		},
+	}
+	assertJobs(t, jobs, expected)
+}
+
+func TestGreenhouse_GetJob(t *testing.T) {
+	tests := []struct {
+		name             string
+		id               string
+		expectedTitle    string
+		expectedKind     apperr.Kind
+		expectedRequests int
+	}{
+		{name: "Success: Job is fetched by its upstream ID", id: "greenhouse-acmejapan:4012346", expectedTitle: "QA Engineer", expectedRequests: 1},
+		{name: "Error: Upstream 404 is NotFound", id: "greenhouse-acmejapan:1", expectedKind: apperr.NotFound, expectedRequests: 1},
+		{name: "Error: Job of another source is NotFound without a request", id: "lever-acme:4012346", expectedKind: apperr.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			server, requests := serveFixtures(t, map[string]string{
+				"/v1/boards/acmejapan/jobs/4012346": "greenhouse_job.json",
+			})
+			src := buildSource(t, Spec{Type: "greenhouse", Board: "acmejapan", Endpoint: server.URL})
+
+			// Act
+			job, err := src.GetJob(context.Background(), tt.id)
+
+			// Assert
+			if *requests != tt.expectedRequests {
+				t.Errorf("Expected %d requests, got %d", tt.expectedRequests, *requests)
+			}
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if job.ID != tt.id || job.Title != tt.expectedTitle || job.Company != "acmejapan" {
+				t.Errorf("Unexpected job %+v", job)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"go.uber.org/zap"
+)
+
+// HERP reads the open requisitions a company publishes on its HERP Hire careers site (https://herp.careers).
+// Postings are written in Japanese, so the salary is kept as text for the salary normalizer. The payload is the one
+// recorded in testdata/herp_requisitions.json; the endpoint of the spec overrides the base URL.
+type HERP struct {
+	base
+	endpoint string
+	company  string
+	display  string
+}
+
+func newHERP(spec Spec, client *http.Client) (ingest.Source, error) {
+	if err := requireBoard(spec); err != nil {
+		return nil, err
+	}
+	return &HERP{
+		base:     base{name: spec.Name, client: client},
+		endpoint: endpointOr(spec, "https://herp.careers"),
+		company:  spec.Board,
+		display:  companyOr(spec, spec.Board),
+	}, nil
+}
+
+type herpRequisition struct {
+	ID             string    `json:"id"`
+	Title          string    `json:"title"`
+	EmploymentType string    `json:"employment_type"` // 正社員, 業務委託 …
+	WorkLocation   string    `json:"work_location"`
+	RemoteWork     string    `json:"remote_work"` // フルリモート, 一部リモート, 出社
+	Salary         string    `json:"salary"`
+	Description    string    `json:"description"` // HTML
+	URL            string    `json:"url"`
+	PublishedAt    time.Time `json:"published_at"`
+}
+
+// GetJobs fetches every open requisition of the company
+func (h *HERP) GetJobs(ctx context.Context) ([]model.Job, error) {
+	requisitionsURL := fmt.Sprintf("%s/v1/%s/requisitions", h.endpoint, url.PathEscape(h.company))
+	logger.Debug(ctx, "Fetching jobs from HERP", zap.String("source", h.name), zap.String("endpoint", requisitionsURL))
+
+	var payload struct {
+		Requisitions []herpRequisition `json:"requisitions"`
+	}
+	if err := httpclient.GetJSON(ctx, h.client, requisitionsURL, &payload); err != nil {
+		return nil, err
+	}
+
+	jobs := make([]model.Job, 0, len(payload.Requisitions))
+	for _, r := range payload.Requisitions {
+		jobs = append(jobs, h.toJob(r))
+	}
+	return jobs, nil
+}
+
+// GetJob fetches a single requisition of the company
+func (h *HERP) GetJob(ctx context.Context, id string) (*model.Job, error) {
+	upstreamID, err := h.upstreamID(id)
+	if err != nil {
+		return nil, err
+	}
+	requisitionURL := fmt.Sprintf("%s/v1/%s/requisitions/%s", h.endpoint, url.PathEscape(h.company), url.PathEscape(upstreamID))
+	logger.Debug(ctx, "Fetching job from HERP", zap.String("source", h.name), zap.String("endpoint", requisitionURL))
+
+	var requisition herpRequisition
+	if err := httpclient.GetJSON(ctx, h.client, requisitionURL, &requisition); err != nil {
+		return nil, httpclient.JobNotFound(err, id)
+	}
+	job := h.toJob(requisition)
+	return &job, nil
+}
+
+func (h *HERP) toJob(r herpRequisition) model.Job {
+	return model.Job{
+		ID:             h.jobID(r.ID),
+		Title:          r.Title,
+		Company:        h.display,
+		Location:       r.WorkLocation,
+		Description:    plainText(r.Description),
+		EmploymentType: employmentType(r.EmploymentType),
+		RemotePolicy:   remotePolicy(r.RemoteWork),
+		SalaryText:     r.Salary,
+		ApplyURL:       r.URL,
+		PostedAt:       r.PublishedAt,
+	}
+}
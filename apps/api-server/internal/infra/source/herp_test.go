@@ -0,0 +1,95 @@
+package source
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+var jst = time.FixedZone("JST", 9*60*60)
+
+func TestHERP_GetJobs(t *testing.T) {
+	// Arrange
+	server, _ := serveFixtures(t, map[string]string{
+		"/v1/kaizen/requisitions": "herp_requisitions.json",
+	})
+	src := buildSource(t, Spec{Type: "herp", Board: "kaizen", Company: "株式会社カイゼン", Endpoint: server.URL})
+
+	// Act
+	jobs, err := src.GetJobs(context.Background())
+
+	// Assert: 給与は原文のまま salary_text に入る
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	expected := []model.Job{
+		{
+			ID:             "herp-kaizen:7c9e6679-7425-40de-944b-e07fc1f90ae7",
+			Title:          "バックエンドエンジニア",
+			Company:        "株式会社カイゼン",
+			Location:       "東京都渋谷区",
+			Description:    "業務内容\nGo による API 開発\n必須スキル\nWeb アプリケーション開発経験 3 年以上",
+			EmploymentType: model.EmploymentFullTime,
+			RemotePolicy:   model.RemoteHybrid,
+			SalaryText:     "年収600万円〜900万円",
+			ApplyURL:       "https://herp.careers/v1/kaizen/7c9e6679-7425-40de-944b-e07fc1f90ae7",
+			PostedAt:       time.Date(2026, 3, 10, 0, 0, 0, 0, jst),
+		},
+		{
+			ID:             "herp-kaizen:16fd2706-8baf-433b-82eb-8c7fada847da",
+			Title:          "業務委託 フロントエンドエンジニア",
+			Company:        "株式会社カイゼン",
+			Location:       "フルリモート",
+			Description:    "React / TypeScript",
+			EmploymentType: model.EmploymentFreelance,
+			RemotePolicy:   model.RemoteFull,
+			SalaryText:     "時給4,000円〜",
+			ApplyURL:       "https://herp.careers/v1/kaizen/16fd2706-8baf-433b-82eb-8c7fada847da",
+			PostedAt:       time.Date(2026, 3, 12, 0, 0, 0, 0, jst),
+		},
+	}
+	assertJobs(t, jobs, expected)
+}
+
+func TestHERP_GetJob(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		expectedTitle string
+		expectedKind  apperr.Kind
+	}{
+		{name: "Success: Requisition is fetched by its upstream ID", id: "herp-kaizen:16fd2706-8baf-433b-82eb-8c7fada847da", expectedTitle: "業務委託 フロントエンドエンジニア"},
+		{name: "Error: Upstream 404 is NotFound", id: "herp-kaizen:missing", expectedKind: apperr.NotFound},
+		{name: "Error: Job of another source is NotFound", id: "herp-other:16fd2706-8baf-433b-82eb-8c7fada847da", expectedKind: apperr.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			server, _ := serveFixtures(t, map[string]string{
+				"/v1/kaizen/requisitions/16fd2706-8baf-433b-82eb-8c7fada847da": "herp_requisition.json",
+			})
+			src := buildSource(t, Spec{Type: "herp", Board: "kaizen", Endpoint: server.URL})
+
+			// Act
+			job, err := src.GetJob(context.Background(), tt.id)
+
+			// Assert
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if job.ID != tt.id || job.Title != tt.expectedTitle {
+				t.Errorf("Unexpected job %+v", job)
+			}
+		})
+	}
+}
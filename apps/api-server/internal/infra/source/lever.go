@@ -0,0 +1,141 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"go.uber.org/zap"
+)
+
+// Lever reads the published postings of a Lever site
+// (https://github.com/lever/postings-api)
+type Lever struct {
+	base
+	endpoint string
+	site     string
+	company  string
+}
+
+func newLever(spec Spec, client *http.Client) (ingest.Source, error) {
+	if err := requireBoard(spec); err != nil {
+		return nil, err
+	}
+	return &Lever{
+		base:     base{name: spec.Name, client: client},
+		endpoint: endpointOr(spec, "https://api.lever.co"),
+		site:     spec.Board,
+		company:  companyOr(spec, spec.Board),
+	}, nil
+}
+
+type leverPosting struct {
+	ID         string `json:"id"`
+	Text       string `json:"text"`
+	Categories struct {
+		Commitment string `json:"commitment"`
+		Location   string `json:"location"`
+	} `json:"categories"`
+	DescriptionPlain string `json:"descriptionPlain"`
+	Lists            []struct {
+		Text    string `json:"text"`
+		Content string `json:"content"` // HTML
+	} `json:"lists"`
+	AdditionalPlain        string `json:"additionalPlain"`
+	HostedURL              string `json:"hostedUrl"`
+	ApplyURL               string `json:"applyUrl"`
+	CreatedAt              int64  `json:"createdAt"` // Unix milliseconds
+	WorkplaceType          string `json:"workplaceType"`
+	SalaryDescriptionPlain string `json:"salaryDescriptionPlain"`
+	SalaryRange            *struct {
+		Currency string `json:"currency"`
+		Interval string `json:"interval"`
+		Min      int64  `json:"min"`
+		Max      int64  `json:"max"`
+	} `json:"salaryRange"`
+}
+
+// leverIntervals maps Lever salary intervals to salary periods
+var leverIntervals = map[string]model.SalaryPeriod{
+	"per-year-salary":  model.SalaryYearly,
+	"per-month-salary": model.SalaryMonthly,
+	"per-hour-wage":    model.SalaryHourly,
+}
+
+// GetJobs fetches every published posting of the site
+func (l *Lever) GetJobs(ctx context.Context) ([]model.Job, error) {
+	postingsURL := fmt.Sprintf("%s/v0/postings/%s?mode=json", l.endpoint, url.PathEscape(l.site))
+	logger.Debug(ctx, "Fetching jobs from Lever", zap.String("source", l.name), zap.String("endpoint", postingsURL))
+
+	var postings []leverPosting
+	if err := httpclient.GetJSON(ctx, l.client, postingsURL, &postings); err != nil {
+		return nil, err
+	}
+
+	jobs := make([]model.Job, 0, len(postings))
+	for _, p := range postings {
+		jobs = append(jobs, l.toJob(p))
+	}
+	return jobs, nil
+}
+
+// GetJob fetches a single posting of the site
+func (l *Lever) GetJob(ctx context.Context, id string) (*model.Job, error) {
+	upstreamID, err := l.upstreamID(id)
+	if err != nil {
+		return nil, err
+	}
+	postingURL := fmt.Sprintf("%s/v0/postings/%s/%s?mode=json", l.endpoint, url.PathEscape(l.site), url.PathEscape(upstreamID))
+	logger.Debug(ctx, "Fetching job from Lever", zap.String("source", l.name), zap.String("endpoint", postingURL))
+
+	var posting leverPosting
+	if err := httpclient.GetJSON(ctx, l.client, postingURL, &posting); err != nil {
+		return nil, httpclient.JobNotFound(err, id)
+	}
+	job := l.toJob(posting)
+	return &job, nil
+}
+
+func (l *Lever) toJob(p leverPosting) model.Job {
+	// The description is split into an introduction, lists such as requirements and a closing text
+	parts := []string{p.DescriptionPlain}
+	for _, list := range p.Lists {
+		parts = append(parts, list.Text+"\n"+plainText(list.Content))
+	}
+	parts = append(parts, p.AdditionalPlain)
+	var description []string
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			description = append(description, part)
+		}
+	}
+
+	job := model.Job{
+		ID:             l.jobID(p.ID),
+		Title:          p.Text,
+		Company:        l.company,
+		Location:       p.Categories.Location,
+		Description:    strings.Join(description, "\n\n"),
+		EmploymentType: employmentType(p.Categories.Commitment),
+		RemotePolicy:   remotePolicy(p.WorkplaceType),
+		SalaryText:     p.SalaryDescriptionPlain,
+		ApplyURL:       p.ApplyURL,
+	}
+	if job.ApplyURL == "" {
+		job.ApplyURL = p.HostedURL
+	}
+	if p.CreatedAt > 0 {
+		job.PostedAt = time.UnixMilli(p.CreatedAt).UTC()
+	}
+	if r := p.SalaryRange; r != nil && leverIntervals[r.Interval] != "" {
+		job.Salary = &model.Salary{Min: r.Min, Max: r.Max, Currency: r.Currency, Period: leverIntervals[r.Interval]}
+	}
+	return job
+}
@@ -0,0 +1,93 @@
+package source
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+func TestLever_GetJobs(t *testing.T) {
+	// Arrange
+	server, _ := serveFixtures(t, map[string]string{
+		"/v0/postings/acme?mode=json": "lever_postings.json",
+	})
+	src := buildSource(t, Spec{Name: "acme", Type: "lever", Board: "acme", Company: "Acme", Endpoint: server.URL})
+
+	// Act
+	jobs, err := src.GetJobs(context.Background())
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	expected := []model.Job{
+		{
+			ID:             "acme:5f0d3c1e-8a2b-4c5d-9e6f-7a8b9c0d1e2f",
+			Title:          "Platform Engineer",
+			Company:        "Acme",
+			Location:       "Tokyo",
+			Description:    "Build the platform that powers our payments.\n\nRequirements\nGo or Rust\nDistributed systems\n\nVisa sponsorship available.",
+			EmploymentType: model.EmploymentFullTime,
+			RemotePolicy:   model.RemoteHybrid,
+			Salary:         &model.Salary{Min: 9000000, Max: 14000000, Currency: "JPY", Period: model.SalaryYearly},
+			SalaryText:     "年収900万円〜1400万円",
+			ApplyURL:       "https://jobs.lever.co/acme/5f0d3c1e-8a2b-4c5d-9e6f-7a8b9c0d1e2f/apply",
+			PostedAt:       time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:             "acme:0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d",
+			Title:          "Product Designer",
+			Company:        "Acme",
+			Location:       "Remote - Japan",
+			Description:    "Design our mobile apps.",
+			EmploymentType: model.EmploymentContract,
+			RemotePolicy:   model.RemoteFull,
+			ApplyURL:       "https://jobs.lever.co/acme/0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d",
+			PostedAt:       time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	assertJobs(t, jobs, expected)
+}
+
+func TestLever_GetJob(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		expectedTitle string
+		expectedKind  apperr.Kind
+	}{
+		{name: "Success: Posting is fetched by its upstream ID", id: "acme:0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d", expectedTitle: "Product Designer"},
+		{name: "Error: Upstream 404 is NotFound", id: "acme:missing", expectedKind: apperr.NotFound},
+		{name: "Error: Job of another source is NotFound", id: "0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d", expectedKind: apperr.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			server, _ := serveFixtures(t, map[string]string{
+				"/v0/postings/acme/0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d?mode=json": "lever_posting.json",
+			})
+			src := buildSource(t, Spec{Name: "acme", Type: "lever", Board: "acme", Endpoint: server.URL})
+
+			// Act
+			job, err := src.GetJob(context.Background(), tt.id)
+
+			// Assert
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if job.ID != tt.id || job.Title != tt.expectedTitle {
+				t.Errorf("Unexpected job %+v", job)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package source
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+// Spec configures one job source. A list of specs is read from the JOB_SOURCES environment variable as JSON.
+type Spec struct {
+	Name     string   `json:"name"`               // unique name, also the prefix of the job IDs. Defaults to "{type}-{board}"
+	Type     string   `json:"type"`               // adapter registered in the Registry, e.g. greenhouse
+	Board    string   `json:"board,omitempty"`    // board token, Lever site, Workable subdomain or HERP company ID
+	Company  string   `json:"company,omitempty"`  // company name, for APIs whose payload does not include it
+	Endpoint string   `json:"endpoint,omitempty"` // base URL, overriding the public API of the adapter
+	Mapping  *Mapping `json:"mapping,omitempty"`  // field mapping of the generic adapter
+}
+
+// Factory creates a source from its spec. client is shared by every source.
+type Factory func(spec Spec, client *http.Client) (ingest.Source, error)
+
+// Registry maps source types to the factories that create them
+type Registry struct {
+	factories map[string]Factory
+}
+
+// NewRegistry creates a Registry with the built-in adapters registered
+func NewRegistry() *Registry {
+	r := &Registry{factories: make(map[string]Factory)}
+	r.Register("api", newAPI)
+	r.Register("greenhouse", newGreenhouse)
+	r.Register("lever", newLever)
+	r.Register("workable", newWorkable)
+	r.Register("herp", newHERP)
+	r.Register("generic", newGeneric)
+	r.Register("feed", newFeed)
+	return r
+}
+
+// Register adds or replaces the factory for typ
+func (r *Registry) Register(typ string, factory Factory) {
+	r.factories[typ] = factory
+}
+
+// Types returns the registered source types in alphabetical order
+func (r *Registry) Types() []string {
+	types := make([]string, 0, len(r.factories))
+	for typ := range r.factories {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Build creates the sources for specs, in order. Source names must be unique because they prefix the job IDs.
+func (r *Registry) Build(specs []Spec, client *http.Client) ([]ingest.Source, error) {
+	sources := make([]ingest.Source, 0, len(specs))
+	names := make(map[string]bool, len(specs))
+	for i, spec := range specs {
+		factory, ok := r.factories[spec.Type]
+		if !ok {
+			return nil, fmt.Errorf("source %d: unknown type %q (available: %s)", i, spec.Type, strings.Join(r.Types(), ", "))
+		}
+		if spec.Name == "" {
+			spec.Name = spec.Type
+			if spec.Board != "" {
+				spec.Name += "-" + spec.Board
+			}
+		}
+		if names[spec.Name] {
+			return nil, fmt.Errorf("source %d: duplicate name %q", i, spec.Name)
+		}
+		names[spec.Name] = true
+
+		src, err := factory(spec, client)
+		if err != nil {
+			return nil, fmt.Errorf("source %q: %w", spec.Name, err)
+		}
+		sources = append(sources, src)
+	}
+	return sources, nil
+}
+
+// ParseSpecs decodes the JSON array of specs in raw
+func ParseSpecs(raw string) ([]Spec, error) {
+	var specs []Spec
+	if err := json.Unmarshal([]byte(raw), &specs); err != nil {
+		return nil, fmt.Errorf("failed to parse source specs: %w", err)
+	}
+	return specs, nil
+}
+
+// base holds what every adapter needs
+type base struct {
+	name   string
+	client *http.Client
+}
+
+func (b base) Name() string {
+	return b.name
+}
+
+// jobID namespaces an upstream ID with the source name, so that IDs of different boards cannot collide
+func (b base) jobID(upstreamID string) string {
+	return b.name + ":" + upstreamID
+}
+
+// upstreamID reverses jobID. IDs of other sources are not found in this one.
+func (b base) upstreamID(id string) (string, error) {
+	upstreamID, ok := strings.CutPrefix(id, b.name+":")
+	if !ok || upstreamID == "" {
+		return "", apperr.New(apperr.NotFound, fmt.Sprintf("job %q is not from source %q", id, b.name))
+	}
+	return upstreamID, nil
+}
+
+// requireBoard returns an error when spec has no board
+func requireBoard(spec Spec) error {
+	if spec.Board == "" {
+		return fmt.Errorf("%s source requires a board", spec.Type)
+	}
+	return nil
+}
+
+// endpointOr returns the spec's endpoint without a trailing slash, or def when it is not set
+func endpointOr(spec Spec, def string) string {
+	if spec.Endpoint != "" {
+		return strings.TrimRight(spec.Endpoint, "/")
+	}
+	return def
+}
+
+// companyOr returns the spec's company, or def when it is not set
+func companyOr(spec Spec, def string) string {
+	if spec.Company != "" {
+		return spec.Company
+	}
+	return def
+}
+
+// employmentType maps the employment types used by ATS boards to model.EmploymentType.
+// Unknown values map to "" so that a job is not rejected for an unusual label.
+func employmentType(s string) model.EmploymentType {
+	key := strings.ToLower(strings.NewReplacer("-", "", "_", "", " ", "").Replace(s))
+	switch key {
+	case "fulltime", "permanent", "正社員":
+		return model.EmploymentFullTime
+	case "contract", "contractor", "temporary", "契約社員", "派遣社員":
+		return model.EmploymentContract
+	case "freelance", "業務委託":
+		return model.EmploymentFreelance
+	case "parttime", "パート", "アルバイト", "パート・アルバイト":
+		return model.EmploymentPartTime
+	case "intern", "internship", "インターン", "インターンシップ":
+		return model.EmploymentInternship
+	}
+	return ""
+}
+
+// remotePolicy maps the workplace types used by ATS boards to model.RemotePolicy
+func remotePolicy(s string) model.RemotePolicy {
+	switch strings.ToLower(strings.ReplaceAll(s, "-", "_")) {
+	case "remote", "full_remote", "fully_remote", "フルリモート":
+		return model.RemoteFull
+	case "hybrid", "一部リモート":
+		return model.RemoteHybrid
+	case "onsite", "on_site", "出社":
+		return model.RemoteOnsite
+	}
+	return ""
+}
+
+var (
+	blockEnd = regexp.MustCompile(`(?i)<br\s*/?>|</(p|div|li|h[1-6])>`)
+	tag      = regexp.MustCompile(`<[^>]*>`)
+	blankRun = regexp.MustCompile(`[ \t]*\n[\s]*`)
+)
+
+// plainText converts an HTML description to plain text, keeping paragraph breaks
+func plainText(s string) string {
+	// Greenhouse escapes the HTML once more, so entities are decoded before and after stripping the tags
+	s = html.UnescapeString(s)
+	s = blockEnd.ReplaceAllString(s, "\n")
+	s = tag.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+	s = blankRun.ReplaceAllString(s, "\n")
+	return strings.TrimSpace(s)
+}
+
+// findJob returns the job with the given ID from jobs, for APIs without a single-job endpoint
+func findJob(jobs []model.Job, id string) (*model.Job, error) {
+	for i := range jobs {
+		if jobs[i].ID == id {
+			return &jobs[i], nil
+		}
+	}
+	return nil, apperr.New(apperr.NotFound, fmt.Sprintf("job %q not found", id))
+}
@@ -0,0 +1,228 @@
+package source
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+)
+
+func TestRegistry_Build(t *testing.T) {
+	tests := []struct {
+		name          string
+		specs         []Spec
+		expectedNames []string
+		expectedErr   string
+	}{
+		{
+			name: "Success: Every built-in type is created and names default to type and board",
+			specs: []Spec{
+				{Type: "api", Endpoint: "https://api.example.com"},
+				{Type: "greenhouse", Board: "acmejapan"},
+				{Name: "acme", Type: "lever", Board: "acme"},
+				{Type: "workable", Board: "sakura"},
+				{Type: "herp", Board: "kaizen"},
+				{Type: "generic", Endpoint: "https://example.com/jobs.json", Mapping: &Mapping{ID: "id", Title: "title"}},
+				{Name: "mirai", Type: "feed", Endpoint: "https://mirai.example/careers.rss"},
+			},
+			expectedNames: []string{"api", "greenhouse-acmejapan", "acme", "workable-sakura", "herp-kaizen", "generic", "mirai"},
+		},
+		{
+			name:        "Error: Unknown type",
+			specs:       []Spec{{Type: "indeed", Board: "acme"}},
+			expectedErr: `source 0: unknown type "indeed"`,
+		},
+		{
+			name:        "Error: Duplicate name",
+			specs:       []Spec{{Type: "lever", Board: "acme"}, {Type: "lever", Board: "acme"}},
+			expectedErr: `source 1: duplicate name "lever-acme"`,
+		},
+		{
+			name:        "Error: Board is required",
+			specs:       []Spec{{Type: "greenhouse"}},
+			expectedErr: "greenhouse source requires a board",
+		},
+		{
+			name:        "Error: API source requires an endpoint",
+			specs:       []Spec{{Type: "api"}},
+			expectedErr: "api source requires an endpoint",
+		},
+		{
+			name:        "Error: Feed source requires an endpoint",
+			specs:       []Spec{{Type: "feed"}},
+			expectedErr: "feed source requires an endpoint",
+		},
+		{
+			name:        "Error: Generic source requires a mapping",
+			specs:       []Spec{{Type: "generic", Endpoint: "https://example.com/jobs.json"}},
+			expectedErr: "generic source requires a mapping",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			sources, err := NewRegistry().Build(tt.specs, http.DefaultClient)
+
+			// Assert
+			if tt.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Fatalf("Expected error containing '%s', got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			var names []string
+			for _, src := range sources {
+				names = append(names, src.Name())
+			}
+			if !reflect.DeepEqual(names, tt.expectedNames) {
+				t.Errorf("Expected names %v, got %v", tt.expectedNames, names)
+			}
+		})
+	}
+}
+
+func TestRegistry_Register(t *testing.T) {
+	// Arrange: 独自のソース種別を登録する
+	r := NewRegistry()
+	r.Register("static", func(spec Spec, client *http.Client) (ingest.Source, error) {
+		return ingest.NamedSource(spec.Name, nil), nil
+	})
+
+	// Act
+	sources, err := r.Build([]Spec{{Name: "fixed", Type: "static"}}, http.DefaultClient)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(sources) != 1 || sources[0].Name() != "fixed" {
+		t.Errorf("Expected source 'fixed', got %v", sources)
+	}
+	if types := r.Types(); !reflect.DeepEqual(types, []string{"api", "feed", "generic", "greenhouse", "herp", "lever", "static", "workable"}) {
+		t.Errorf("Unexpected types %v", types)
+	}
+}
+
+func TestParseSpecs(t *testing.T) {
+	// Act
+	specs, err := ParseSpecs(`[
+		{"type":"greenhouse","board":"acmejapan","company":"Acme Japan"},
+		{"name":"feed","type":"generic","endpoint":"https://example.com/jobs.json","mapping":{"jobs":"data","id":"id","title":"name"}}
+	]`)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	expected := []Spec{
+		{Type: "greenhouse", Board: "acmejapan", Company: "Acme Japan"},
+		{Name: "feed", Type: "generic", Endpoint: "https://example.com/jobs.json", Mapping: &Mapping{Jobs: "data", ID: "id", Title: "name"}},
+	}
+	if !reflect.DeepEqual(specs, expected) {
+		t.Errorf("Specs mismatch:\n  expected: %+v\n  got:      %+v", expected, specs)
+	}
+
+	if _, err := ParseSpecs(`{"type":"lever"}`); err == nil {
+		t.Error("Expected an error for a spec that is not an array")
+	}
+}
+
+func TestEmploymentType(t *testing.T) {
+	tests := map[string]model.EmploymentType{
+		"Full-time":  model.EmploymentFullTime,
+		"FULL_TIME":  model.EmploymentFullTime,
+		"正社員":        model.EmploymentFullTime,
+		"Contractor": model.EmploymentContract,
+		"業務委託":       model.EmploymentFreelance,
+		"Part-time":  model.EmploymentPartTime,
+		"Internship": model.EmploymentInternship,
+		"Volunteer":  "",
+	}
+	for input, expected := range tests {
+		if got := employmentType(input); got != expected {
+			t.Errorf("employmentType(%q): expected '%s', got '%s'", input, expected, got)
+		}
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "Tags are removed and blocks become lines", input: "<p>Go <b>engineer</b></p><ul><li>AWS</li><li>k8s</li></ul>", expected: "Go engineer\nAWS\nk8s"},
+		{name: "Escaped HTML is decoded first", input: "&lt;p&gt;R&amp;amp;D&lt;/p&gt;", expected: "R&D"},
+		{name: "Plain text is kept", input: "年収600万円〜", expected: "年収600万円〜"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := plainText(tt.input); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// serveFixtures serves testdata files by request URI, e.g. "/v1/boards/acme/jobs?content=true": "greenhouse_jobs.json".
+// Unknown URIs get a 404. The server counts the requests it received.
+func serveFixtures(t *testing.T, routes map[string]string) (*httptest.Server, *int) {
+	t.Helper()
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fixture, ok := routes[r.URL.RequestURI()]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		body, err := os.ReadFile(filepath.Join("testdata", fixture))
+		if err != nil {
+			t.Errorf("Failed to read fixture %s: %v", fixture, err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+// buildSource creates a single source from spec
+func buildSource(t *testing.T, spec Spec) ingest.Source {
+	t.Helper()
+	sources, err := NewRegistry().Build([]Spec{spec}, http.DefaultClient)
+	if err != nil {
+		t.Fatalf("Failed to build source: %v", err)
+	}
+	return sources[0]
+}
+
+// assertJobs compares jobs field by field, so that a mismatch names the job
+func assertJobs(t *testing.T, got, expected []model.Job) {
+	t.Helper()
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d jobs, got %d: %+v", len(expected), len(got), got)
+	}
+	for i := range expected {
+		if !got[i].PostedAt.Equal(expected[i].PostedAt) {
+			t.Errorf("Job[%d]: expected posted_at %v, got %v", i, expected[i].PostedAt, got[i].PostedAt)
+		}
+		got[i].PostedAt, expected[i].PostedAt = time.Time{}, time.Time{}
+		if !reflect.DeepEqual(got[i], expected[i]) {
+			t.Errorf("Job[%d] mismatch:\n  expected: %+v\n  got:      %+v", i, expected[i], got[i])
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/ingest"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/infra/httpclient"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"go.uber.org/zap"
+)
+
+// Workable reads the published jobs of a Workable account through its careers widget API.
+// The widget API has no single-job endpoint, so GetJob looks the job up in the full list.
+type Workable struct {
+	base
+	endpoint  string
+	subdomain string
+	company   string
+}
+
+func newWorkable(spec Spec, client *http.Client) (ingest.Source, error) {
+	if err := requireBoard(spec); err != nil {
+		return nil, err
+	}
+	return &Workable{
+		base:      base{name: spec.Name, client: client},
+		endpoint:  endpointOr(spec, "https://apply.workable.com"),
+		subdomain: spec.Board,
+		company:   spec.Company,
+	}, nil
+}
+
+type workableJob struct {
+	Title          string `json:"title"`
+	Shortcode      string `json:"shortcode"`
+	EmploymentType string `json:"employment_type"`
+	Telecommuting  bool   `json:"telecommuting"`
+	URL            string `json:"url"`
+	ApplicationURL string `json:"application_url"`
+	PublishedOn    string `json:"published_on"` // YYYY-MM-DD
+	Country        string `json:"country"`
+	State          string `json:"state"`
+	City           string `json:"city"`
+	Description    string `json:"description"` // HTML
+}
+
+// GetJobs fetches every published job of the account
+func (w *Workable) GetJobs(ctx context.Context) ([]model.Job, error) {
+	accountURL := fmt.Sprintf("%s/api/v1/widget/accounts/%s?details=true", w.endpoint, url.PathEscape(w.subdomain))
+	logger.Debug(ctx, "Fetching jobs from Workable", zap.String("source", w.name), zap.String("endpoint", accountURL))
+
+	var payload struct {
+		Name string        `json:"name"`
+		Jobs []workableJob `json:"jobs"`
+	}
+	if err := httpclient.GetJSON(ctx, w.client, accountURL, &payload); err != nil {
+		return nil, err
+	}
+
+	company := w.company
+	if company == "" {
+		company = payload.Name
+	}
+	jobs := make([]model.Job, 0, len(payload.Jobs))
+	for _, j := range payload.Jobs {
+		jobs = append(jobs, w.toJob(j, company))
+	}
+	return jobs, nil
+}
+
+// GetJob finds a single job in the account's job list
+func (w *Workable) GetJob(ctx context.Context, id string) (*model.Job, error) {
+	if _, err := w.upstreamID(id); err != nil {
+		return nil, err
+	}
+	jobs, err := w.GetJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return findJob(jobs, id)
+}
+
+func (w *Workable) toJob(j workableJob, company string) model.Job {
+	var location []string
+	for _, part := range []string{j.City, j.State, j.Country} {
+		if part != "" {
+			location = append(location, part)
+		}
+	}
+
+	job := model.Job{
+		ID:             w.jobID(j.Shortcode),
+		Title:          j.Title,
+		Company:        company,
+		Location:       strings.Join(location, ", "),
+		Description:    plainText(j.Description),
+		EmploymentType: employmentType(j.EmploymentType),
+		ApplyURL:       j.ApplicationURL,
+	}
+	if job.ApplyURL == "" {
+		job.ApplyURL = j.URL
+	}
+	// telecommuting only says that remote work is possible, not whether it is full or partial
+	if j.Telecommuting {
+		job.RemotePolicy = model.RemoteHybrid
+	}
+	if postedAt, err := time.Parse(time.DateOnly, j.PublishedOn); err == nil {
+		job.PostedAt = postedAt
+	}
+	return job
+}
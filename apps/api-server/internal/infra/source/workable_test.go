@@ -0,0 +1,94 @@
+package source
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/domain/model"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+)
+
+func TestWorkable_GetJobs(t *testing.T) {
+	// Arrange: 会社名は payload の name を使う
+	server, _ := serveFixtures(t, map[string]string{
+		"/api/v1/widget/accounts/sakura?details=true": "workable_account.json",
+	})
+	src := buildSource(t, Spec{Type: "workable", Board: "sakura", Endpoint: server.URL})
+
+	// Act
+	jobs, err := src.GetJobs(context.Background())
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	expected := []model.Job{
+		{
+			ID:             "workable-sakura:A1B2C3D4E5",
+			Title:          "Embedded Software Engineer",
+			Company:        "Sakura Robotics",
+			Location:       "Fukuoka, Fukuoka, Japan",
+			Description:    "Write firmware for C++ based controllers.\n日本語 N2 以上",
+			EmploymentType: model.EmploymentFullTime,
+			ApplyURL:       "https://apply.workable.com/j/A1B2C3D4E5/apply",
+			PostedAt:       time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:             "workable-sakura:F6G7H8I9J0",
+			Title:          "Data Analyst (Part-time)",
+			Company:        "Sakura Robotics",
+			Location:       "Japan",
+			Description:    "SQL and dashboards.",
+			EmploymentType: model.EmploymentPartTime,
+			RemotePolicy:   model.RemoteHybrid,
+			ApplyURL:       "https://apply.workable.com/j/F6G7H8I9J0",
+			PostedAt:       time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	assertJobs(t, jobs, expected)
+}
+
+func TestWorkable_GetJob(t *testing.T) {
+	tests := []struct {
+		name             string
+		id               string
+		expectedTitle    string
+		expectedKind     apperr.Kind
+		expectedRequests int
+	}{
+		{name: "Success: Job is found in the job list", id: "workable-sakura:F6G7H8I9J0", expectedTitle: "Data Analyst (Part-time)", expectedRequests: 1},
+		{name: "Error: Job missing from the list is NotFound", id: "workable-sakura:ZZZZZZZZZZ", expectedKind: apperr.NotFound, expectedRequests: 1},
+		{name: "Error: Job of another source is NotFound without a request", id: "herp-kaizen:F6G7H8I9J0", expectedKind: apperr.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			server, requests := serveFixtures(t, map[string]string{
+				"/api/v1/widget/accounts/sakura?details=true": "workable_account.json",
+			})
+			src := buildSource(t, Spec{Type: "workable", Board: "sakura", Endpoint: server.URL})
+
+			// Act
+			job, err := src.GetJob(context.Background(), tt.id)
+
+			// Assert
+			if *requests != tt.expectedRequests {
+				t.Errorf("Expected %d requests, got %d", tt.expectedRequests, *requests)
+			}
+			if tt.expectedKind != "" {
+				if apperr.KindOf(err) != tt.expectedKind {
+					t.Fatalf("Expected error kind '%s', got '%v'", tt.expectedKind, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if job.ID != tt.id || job.Title != tt.expectedTitle {
+				t.Errorf("Unexpected job %+v", job)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package apperr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kind classifies an error so that outer layers can react without inspecting messages
+type Kind string
+
+// Kind values double as the stable machine-readable error codes returned to API clients
+const (
+	Internal            Kind = "internal"
+	NotFound            Kind = "not_found"
+	InvalidArgument     Kind = "invalid_argument"
+	UpstreamUnavailable Kind = "upstream_unavailable"
+	UpstreamTimeout     Kind = "upstream_timeout"
+	RateLimited         Kind = "rate_limited"
+)
+
+// FieldViolation describes why a single request field is invalid
+type FieldViolation struct {
+	Field  string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// Error is an error annotated with a Kind
+type Error struct {
+	Kind    Kind
+	Message string
+	Err     error
+	// Fields lists the offending fields of an InvalidArgument error
+	Fields []FieldViolation
+}
+
+// New creates a new Error of the given kind
+func New(kind Kind, message string) *Error {
+	return &Error{Kind: kind, Message: message}
+}
+
+// Wrap annotates err with a kind and message
+func Wrap(kind Kind, err error, message string) *Error {
+	return &Error{Kind: kind, Message: message, Err: err}
+}
+
+// Invalid creates an InvalidArgument error listing every offending field
+func Invalid(fields []FieldViolation) *Error {
+	return &Error{Kind: InvalidArgument, Message: "invalid parameters", Fields: fields}
+}
+
+func (e *Error) Error() string {
+	if len(e.Fields) > 0 {
+		msg := e.Message
+		for i, f := range e.Fields {
+			sep := ", "
+			if i == 0 {
+				sep = ": "
+			}
+			msg += fmt.Sprintf("%s%s %s", sep, f.Field, f.Reason)
+		}
+		return msg
+	}
+	if e.Err == nil {
+		return e.Message
+	}
+	if e.Message == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s: %v", e.Message, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// KindOf returns the kind of the first *Error in err's chain, or Internal if there is none
+func KindOf(err error) Kind {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Kind
+	}
+	return Internal
+}
+
+// Is reports whether err carries the given kind
+func Is(err error, kind Kind) bool {
+	return err != nil && KindOf(err) == kind
+}
+
+// FieldsOf returns the field violations of the first *Error in err's chain
+func FieldsOf(err error) []FieldViolation {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Fields
+	}
+	return nil
+}
+
+// MessageOf returns the first non-empty message of the *Error values in err's chain, or "" if there is none.
+// Unlike Error(), it leaves out the causes, which may carry upstream URLs and transport details.
+func MessageOf(err error) string {
+	var appErr *Error
+	for errors.As(err, &appErr) {
+		if appErr.Message != "" {
+			return appErr.Message
+		}
+		err = appErr.Err
+	}
+	return ""
+}
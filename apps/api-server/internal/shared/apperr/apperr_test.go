@@ -0,0 +1,117 @@
+package apperr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestKindOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected Kind
+	}{
+		{
+			name:     "Error created with New",
+			err:      New(NotFound, "job not found"),
+			expected: NotFound,
+		},
+		{
+			name:     "Error wrapped with fmt.Errorf",
+			err:      fmt.Errorf("service: %w", Wrap(UpstreamTimeout, errors.New("deadline exceeded"), "upstream")),
+			expected: UpstreamTimeout,
+		},
+		{
+			name:     "Plain error falls back to Internal",
+			err:      errors.New("boom"),
+			expected: Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			kind := KindOf(tt.err)
+
+			// Assert
+			if kind != tt.expected {
+				t.Errorf("Expected kind '%s', got '%s'", tt.expected, kind)
+			}
+		})
+	}
+}
+
+func TestError_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *Error
+		expected string
+	}{
+		{
+			name:     "Message only",
+			err:      New(InvalidArgument, "limit must be positive"),
+			expected: "limit must be positive",
+		},
+		{
+			name:     "Message and cause",
+			err:      Wrap(UpstreamUnavailable, errors.New("connection refused"), "upstream request failed"),
+			expected: "upstream request failed: connection refused",
+		},
+		{
+			name:     "Cause only",
+			err:      Wrap(UpstreamUnavailable, errors.New("connection refused"), ""),
+			expected: "connection refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act & Assert
+			if tt.err.Error() != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, tt.err.Error())
+			}
+		})
+	}
+}
+
+func TestMessageOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name:     "Message without the cause",
+			err:      Wrap(UpstreamUnavailable, errors.New("dial tcp 10.0.0.1:443: connection refused"), "upstream request failed"),
+			expected: "upstream request failed",
+		},
+		{
+			name:     "Outermost message wins",
+			err:      fmt.Errorf("service: %w", Wrap(NotFound, New(NotFound, "inner"), "job \"1\" not found")),
+			expected: "job \"1\" not found",
+		},
+		{
+			name:     "Empty message falls through to the wrapped error",
+			err:      Wrap(UpstreamUnavailable, Wrap(UpstreamTimeout, errors.New("deadline exceeded"), "upstream request timed out"), ""),
+			expected: "upstream request timed out",
+		},
+		{
+			name:     "Plain error has no message",
+			err:      errors.New("boom"),
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			message := MessageOf(tt.err)
+
+			// Assert
+			if message != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, message)
+			}
+		})
+	}
+}
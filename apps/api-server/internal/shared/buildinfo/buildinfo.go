@@ -0,0 +1,56 @@
+// Package buildinfo reports which build of the binary is running
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Set at build time, for example:
+//
+//	go build -ldflags "-X github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/buildinfo.commit=$(git rev-parse HEAD) \
+//	  -X github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/buildinfo.buildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+var (
+	commit    string
+	buildTime string
+)
+
+// unknown is reported when neither the linker flags nor the VCS stamp of the toolchain provide a value
+const unknown = "unknown"
+
+// Info describes the running build
+type Info struct {
+	Commit    string `json:"commit"`
+	BuildTime string `json:"build_time"`
+	GoVersion string `json:"go_version"`
+}
+
+// Get returns the build info. Values missing from the linker flags fall back to the VCS revision and commit time that
+// the go command stamps into binaries built inside a git checkout.
+func Get() Info {
+	var settings []debug.BuildSetting
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		settings = bi.Settings
+	}
+	return resolve(commit, buildTime, settings)
+}
+
+// resolve combines the linker flags with the build settings of the binary, the linker flags taking precedence
+func resolve(commit, buildTime string, settings []debug.BuildSetting) Info {
+	info := Info{Commit: commit, BuildTime: buildTime, GoVersion: runtime.Version()}
+	for _, s := range settings {
+		switch {
+		case s.Key == "vcs.revision" && info.Commit == "":
+			info.Commit = s.Value
+		case s.Key == "vcs.time" && info.BuildTime == "":
+			info.BuildTime = s.Value
+		}
+	}
+	if info.Commit == "" {
+		info.Commit = unknown
+	}
+	if info.BuildTime == "" {
+		info.BuildTime = unknown
+	}
+	return info
+}
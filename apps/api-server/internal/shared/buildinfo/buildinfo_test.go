@@ -0,0 +1,108 @@
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+	"testing"
+)
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		name              string
+		commit            string
+		buildTime         string
+		expectedCommit    string
+		expectedBuildTime string
+	}{
+		{
+			name:              "Linker flags are reported",
+			commit:            "0123456789abcdef",
+			buildTime:         "2026-04-01T09:00:00Z",
+			expectedCommit:    "0123456789abcdef",
+			expectedBuildTime: "2026-04-01T09:00:00Z",
+		},
+		{
+			// テストバイナリには VCS の情報が埋め込まれない
+			name:              "Unknown without linker flags",
+			expectedCommit:    unknown,
+			expectedBuildTime: unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			previousCommit, previousBuildTime := commit, buildTime
+			t.Cleanup(func() { commit, buildTime = previousCommit, previousBuildTime })
+			commit, buildTime = tt.commit, tt.buildTime
+
+			// Act
+			info := Get()
+
+			// Assert
+			if info.Commit != tt.expectedCommit || info.BuildTime != tt.expectedBuildTime {
+				t.Errorf("Expected commit '%s' built at '%s', got %+v", tt.expectedCommit, tt.expectedBuildTime, info)
+			}
+			if info.GoVersion != runtime.Version() {
+				t.Errorf("Expected Go version '%s', got '%s'", runtime.Version(), info.GoVersion)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	vcs := []debug.BuildSetting{
+		{Key: "vcs", Value: "git"},
+		{Key: "vcs.revision", Value: "fedcba9876543210"},
+		{Key: "vcs.time", Value: "2026-03-31T12:00:00Z"},
+	}
+	tests := []struct {
+		name              string
+		commit            string
+		buildTime         string
+		settings          []debug.BuildSetting
+		expectedCommit    string
+		expectedBuildTime string
+	}{
+		{
+			// Dockerfile でビルド引数を渡さない場合
+			name:              "VCS stamp is used without linker flags",
+			settings:          vcs,
+			expectedCommit:    "fedcba9876543210",
+			expectedBuildTime: "2026-03-31T12:00:00Z",
+		},
+		{
+			name:              "Linker flags take precedence over the VCS stamp",
+			commit:            "0123456789abcdef",
+			buildTime:         "2026-04-01T09:00:00Z",
+			settings:          vcs,
+			expectedCommit:    "0123456789abcdef",
+			expectedBuildTime: "2026-04-01T09:00:00Z",
+		},
+		{
+			name:              "Missing values fall back separately",
+			commit:            "0123456789abcdef",
+			settings:          vcs,
+			expectedCommit:    "0123456789abcdef",
+			expectedBuildTime: "2026-03-31T12:00:00Z",
+		},
+		{
+			name:              "Unknown without linker flags or VCS stamp",
+			settings:          []debug.BuildSetting{{Key: "GOOS", Value: "linux"}},
+			expectedCommit:    unknown,
+			expectedBuildTime: unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			info := resolve(tt.commit, tt.buildTime, tt.settings)
+
+			// Assert
+			if info.Commit != tt.expectedCommit || info.BuildTime != tt.expectedBuildTime {
+				t.Errorf("Expected commit '%s' built at '%s', got %+v", tt.expectedCommit, tt.expectedBuildTime, info)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package cursor
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type position struct {
+	ID       string `json:"id"`
+	Backward bool   `json:"b,omitempty"`
+}
+
+func TestCodec_RoundTrip(t *testing.T) {
+	// Arrange
+	codec := NewCodec("secret")
+	expected := position{ID: "job-42", Backward: true}
+
+	// Act
+	encoded, err := codec.Encode(expected)
+	if err != nil {
+		t.Fatalf("Failed to encode: %v", err)
+	}
+	var got position
+	err = codec.Decode(encoded, &got)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got != expected {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+	if strings.Contains(encoded, "job-42") {
+		t.Errorf("Expected cursor to be opaque, got '%s'", encoded)
+	}
+}
+
+func TestCodec_Decode_Invalid(t *testing.T) {
+	valid, _ := NewCodec("secret").Encode(position{ID: "1"})
+	payload, _, _ := strings.Cut(valid, ".")
+
+	tests := []struct {
+		name   string
+		cursor string
+		codec  *Codec
+	}{
+		{name: "Missing signature", cursor: payload, codec: NewCodec("secret")},
+		{name: "Signed with another secret", cursor: valid, codec: NewCodec("other")},
+		{name: "Tampered payload", cursor: "eyJpZCI6IjIifQ." + strings.SplitN(valid, ".", 2)[1], codec: NewCodec("secret")},
+		{name: "Not base64", cursor: "!!!.???", codec: NewCodec("secret")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			var got position
+			err := tt.codec.Decode(tt.cursor, &got)
+
+			// Assert
+			if !errors.Is(err, ErrInvalid) {
+				t.Errorf("Expected ErrInvalid, got %v", err)
+			}
+		})
+	}
+}
@@ -2,6 +2,10 @@ package logger
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -11,58 +15,121 @@ type ContextKey string
 
 const TraceID = ContextKey("trace_id")
 
-var log *zap.Logger
+// TraceIDHeader carries the trace ID between services, in requests and responses alike
+const TraceIDHeader = "X-Request-Id"
+
+// Config controls how the logger writes
+type Config struct {
+	Level       string // debug, info, warn, error
+	Environment string // local writes human-readable console lines, anything else JSON
+	// Sampling keeps the first SamplingInitial entries with the same level and message every second, then every
+	// SamplingThereafter-th of them. SamplingInitial <= 0 disables sampling.
+	SamplingInitial    int
+	SamplingThereafter int
+}
+
+var (
+	log atomic.Pointer[zap.Logger]
+	// output is where the logger writes; it is locked so that other writers can share it without interleaving lines
+	output = zapcore.Lock(os.Stdout)
+	// level is shared by every logger Init builds, so that SetLevel keeps working across re-initialization
+	level = zap.NewAtomicLevel()
+)
 
 func init() {
-	var err error
-
-	conf := zap.Config{
-		Level:       zap.NewAtomicLevel(),
-		Development: false,
-		Encoding:    "json",
-		EncoderConfig: zapcore.EncoderConfig{
-			TimeKey:        "timestamp",
-			LevelKey:       "level",
-			NameKey:        "name",
-			CallerKey:      "caller",
-			MessageKey:     "msg",
-			StacktraceKey:  "stacktrace",
-			EncodeLevel:    zapcore.LowercaseLevelEncoder,
-			EncodeTime:     zapcore.ISO8601TimeEncoder,
-			EncodeDuration: zapcore.StringDurationEncoder,
-			EncodeCaller:   zapcore.ShortCallerEncoder,
-		},
-		OutputPaths:      []string{"stdout"},
-		ErrorOutputPaths: []string{"stderr"},
-	}
+	// Until Init is called, log JSON at info level
+	log.Store(build(Config{}, output))
+}
 
-	log, err = conf.Build()
+// Init replaces the logger with one built from cfg
+func Init(cfg Config) error {
+	l, err := zapcore.ParseLevel(cfg.Level)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("invalid log level %q: %w", cfg.Level, err)
 	}
+	level.SetLevel(l)
+	log.Store(build(cfg, output))
+	return nil
+}
+
+// build creates a logger writing to out
+func build(cfg Config, out zapcore.WriteSyncer) *zap.Logger {
+	encoderConfig := zapcore.EncoderConfig{
+		TimeKey:        "timestamp",
+		LevelKey:       "level",
+		NameKey:        "name",
+		CallerKey:      "caller",
+		MessageKey:     "msg",
+		StacktraceKey:  "stacktrace",
+		EncodeLevel:    zapcore.LowercaseLevelEncoder,
+		EncodeTime:     zapcore.ISO8601TimeEncoder,
+		EncodeDuration: zapcore.StringDurationEncoder,
+		EncodeCaller:   zapcore.ShortCallerEncoder,
+	}
+
+	var encoder zapcore.Encoder
+	if cfg.Environment == "local" {
+		encoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+		encoder = zapcore.NewConsoleEncoder(encoderConfig)
+	} else {
+		encoder = zapcore.NewJSONEncoder(encoderConfig)
+	}
+
+	core := zapcore.NewCore(encoder, out, level)
+	if cfg.SamplingInitial > 0 {
+		core = zapcore.NewSamplerWithOptions(core, time.Second, cfg.SamplingInitial, cfg.SamplingThereafter)
+	}
+	// The logging functions below add one frame
+	return zap.New(core, zap.AddCaller(), zap.AddCallerSkip(1), zap.AddStacktrace(zapcore.ErrorLevel), zap.ErrorOutput(zapcore.Lock(os.Stderr)))
+}
+
+// Replace replaces the logger with l and returns the previous one
+func Replace(l *zap.Logger) *zap.Logger {
+	return log.Swap(l)
+}
+
+// Output returns the writer the logger writes to, for output that must reach the same log stream, such as metrics
+func Output() zapcore.WriteSyncer {
+	return output
+}
+
+// Level returns the current log level
+func Level() zapcore.Level {
+	return level.Level()
+}
+
+// SetLevel changes the log level at runtime
+func SetLevel(l zapcore.Level) {
+	level.SetLevel(l)
 }
 
 func Info(ctx context.Context, message string, fields ...zap.Field) {
-	fields = append(fields, zap.String("trace_id", retrieveTraceID(ctx)))
-	log.Info(message, fields...)
+	fields = append(fields, zap.String("trace_id", TraceIDFrom(ctx)))
+	log.Load().Info(message, fields...)
 }
 
 func Debug(ctx context.Context, message string, fields ...zap.Field) {
-	fields = append(fields, zap.String("trace_id", retrieveTraceID(ctx)))
-	log.Debug(message, fields...)
+	fields = append(fields, zap.String("trace_id", TraceIDFrom(ctx)))
+	log.Load().Debug(message, fields...)
 }
 
 func Error(ctx context.Context, message string, fields ...zap.Field) {
-	fields = append(fields, zap.String("trace_id", retrieveTraceID(ctx)))
-	log.Error(message, fields...)
+	fields = append(fields, zap.String("trace_id", TraceIDFrom(ctx)))
+	log.Load().Error(message, fields...)
 }
 
 func Warn(ctx context.Context, message string, fields ...zap.Field) {
-	fields = append(fields, zap.String("trace_id", retrieveTraceID(ctx)))
-	log.Warn(message, fields...)
+	fields = append(fields, zap.String("trace_id", TraceIDFrom(ctx)))
+	log.Load().Warn(message, fields...)
+}
+
+// WithTraceID returns a copy of ctx whose log entries carry traceID
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, TraceID, traceID)
 }
 
-func retrieveTraceID(ctx context.Context) string {
+// TraceIDFrom returns the trace ID stored in ctx, or an empty string
+func TraceIDFrom(ctx context.Context) string {
 	traceID, _ := ctx.Value(TraceID).(string)
 	return traceID
 }
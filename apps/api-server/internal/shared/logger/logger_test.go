@@ -0,0 +1,132 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+)
+
+func TestInit(t *testing.T) {
+	tests := []struct {
+		name          string
+		cfg           Config
+		expectedLevel zapcore.Level
+		expectError   bool
+	}{
+		{
+			name:          "Success: Level is applied",
+			cfg:           Config{Level: "debug", Environment: "dev"},
+			expectedLevel: zapcore.DebugLevel,
+		},
+		{
+			name:          "Success: Upper case level",
+			cfg:           Config{Level: "ERROR", Environment: "prod"},
+			expectedLevel: zapcore.ErrorLevel,
+		},
+		{
+			name:        "Error: Unknown level",
+			cfg:         Config{Level: "verbose", Environment: "prod"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			t.Cleanup(func() { SetLevel(zapcore.InfoLevel) })
+
+			// Act
+			err := Init(tt.cfg)
+
+			// Assert
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if Level() != tt.expectedLevel {
+				t.Errorf("Expected level %s, got %s", tt.expectedLevel, Level())
+			}
+		})
+	}
+}
+
+func TestBuild_Encoding(t *testing.T) {
+	tests := []struct {
+		name        string
+		environment string
+		expectJSON  bool
+	}{
+		{name: "Local: Console encoder", environment: "local"},
+		{name: "Dev: JSON encoder", environment: "dev", expectJSON: true},
+		{name: "Prod: JSON encoder", environment: "prod", expectJSON: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var buf bytes.Buffer
+			l := build(Config{Environment: tt.environment}, zapcore.AddSync(&buf))
+
+			// Act
+			l.Info("Jobs fetched")
+
+			// Assert
+			var entry map[string]any
+			isJSON := json.Unmarshal(buf.Bytes(), &entry) == nil
+			if isJSON != tt.expectJSON {
+				t.Fatalf("Expected JSON=%v, got %q", tt.expectJSON, buf.String())
+			}
+			if isJSON && (entry["msg"] != "Jobs fetched" || entry["level"] != "info") {
+				t.Errorf("Unexpected entry: %v", entry)
+			}
+			if !isJSON && !strings.Contains(buf.String(), "Jobs fetched") {
+				t.Errorf("Expected the message in %q", buf.String())
+			}
+		})
+	}
+}
+
+func TestBuild_Sampling(t *testing.T) {
+	// Arrange: 同じメッセージは最初の2件、以降は3件ごとに1件
+	var buf bytes.Buffer
+	l := build(Config{Environment: "prod", SamplingInitial: 2, SamplingThereafter: 3}, zapcore.AddSync(&buf))
+
+	// Act
+	for range 8 {
+		l.Info("Serving upstream response from cache")
+	}
+	l.Info("Jobs fetched")
+
+	// Assert: 1, 2, 5, 8 件目と別メッセージ
+	if lines := strings.Count(buf.String(), "\n"); lines != 5 {
+		t.Errorf("Expected 5 sampled lines, got %d: %s", lines, buf.String())
+	}
+}
+
+func TestSetLevel(t *testing.T) {
+	// Arrange
+	t.Cleanup(func() { SetLevel(zapcore.InfoLevel) })
+	var buf bytes.Buffer
+	l := build(Config{Environment: "prod"}, zapcore.AddSync(&buf))
+
+	// Act: 構築済みのロガーにも反映される
+	l.Debug("Before")
+	SetLevel(zapcore.DebugLevel)
+	l.Debug("After")
+
+	// Assert
+	if strings.Contains(buf.String(), "Before") || !strings.Contains(buf.String(), "After") {
+		t.Errorf("Expected only the entry after SetLevel, got %q", buf.String())
+	}
+	if Level() != zapcore.DebugLevel {
+		t.Errorf("Expected debug level, got %s", Level())
+	}
+}
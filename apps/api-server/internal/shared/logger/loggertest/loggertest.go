@@ -0,0 +1,22 @@
+// Package loggertest records the log entries of a test in memory
+package loggertest
+
+import (
+	"testing"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// Setup installs a logger that records every entry, at any level, into the returned logs. The previous logger is
+// restored when the test ends, so tests using Setup must not run in parallel.
+func Setup(t *testing.T) *observer.ObservedLogs {
+	t.Helper()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	previous := logger.Replace(zap.New(core))
+	t.Cleanup(func() { logger.Replace(previous) })
+	return logs
+}
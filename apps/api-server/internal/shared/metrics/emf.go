@@ -0,0 +1,142 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"slices"
+	"sync"
+	"time"
+)
+
+// maxEMFValues is the most samples CloudWatch accepts for one metric in one EMF document
+const maxEMFValues = 100
+
+// emfGroup holds the metrics recorded with one set of label values, which become one EMF document
+type emfGroup struct {
+	labels   []Label
+	counters map[string]float64
+	samples  map[string][]float64
+}
+
+// EMF collects metrics and writes them as CloudWatch Embedded Metric Format documents on Flush.
+// CloudWatch Logs extracts the metrics from the log lines, so nothing is sent to the CloudWatch API.
+type EMF struct {
+	out       io.Writer
+	namespace string
+	now       func() time.Time
+
+	mu     sync.Mutex
+	groups map[string]*emfGroup
+}
+
+// NewEMF creates an EMF recorder writing one JSON document per line to out
+func NewEMF(out io.Writer, namespace string) *EMF {
+	return &EMF{out: out, namespace: namespace, now: time.Now, groups: make(map[string]*emfGroup)}
+}
+
+// Add implements Recorder
+func (e *EMF) Add(name string, value float64, labels ...Label) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.group(labels).counters[name] += value
+}
+
+// Observe implements Recorder
+func (e *EMF) Observe(name string, value float64, labels ...Label) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	g := e.group(labels)
+	g.samples[name] = append(g.samples[name], value)
+}
+
+// group returns the group of labels, creating it when needed. e.mu must be held.
+func (e *EMF) group(labels []Label) *emfGroup {
+	s := newSeries("", labels)
+	key := labelKey(s.labels)
+	g, ok := e.groups[key]
+	if !ok {
+		g = &emfGroup{labels: s.labels, counters: make(map[string]float64), samples: make(map[string][]float64)}
+		e.groups[key] = g
+	}
+	return g
+}
+
+// Flush implements Recorder. It writes the metrics recorded since the last flush and forgets them.
+func (e *EMF) Flush(ctx context.Context) error {
+	e.mu.Lock()
+	groups := e.groups
+	e.groups = make(map[string]*emfGroup)
+	e.mu.Unlock()
+
+	timestamp := e.now().UnixMilli()
+	for _, key := range sortedKeys(groups) {
+		for _, doc := range e.documents(groups[key], timestamp) {
+			line, err := json.Marshal(doc)
+			if err != nil {
+				return err
+			}
+			if _, err := e.out.Write(append(line, '\n')); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// documents builds the EMF documents of g. Distributions with more samples than one document may hold are split
+// across several documents; counters go into the first one.
+func (e *EMF) documents(g *emfGroup, timestamp int64) []map[string]any {
+	count := 1
+	for _, values := range g.samples {
+		count = max(count, (len(values)+maxEMFValues-1)/maxEMFValues)
+	}
+
+	dimensions := make([]string, len(g.labels))
+	for i, l := range g.labels {
+		dimensions[i] = l.Name
+	}
+
+	docs := make([]map[string]any, 0, count)
+	for i := range count {
+		doc := make(map[string]any)
+		for _, l := range g.labels {
+			doc[l.Name] = l.Value
+		}
+		var metrics []map[string]string
+		if i == 0 {
+			for _, name := range sortedKeys(g.counters) {
+				doc[name] = g.counters[name]
+				metrics = append(metrics, map[string]string{"Name": name, "Unit": definitions[name].unit})
+			}
+		}
+		for _, name := range sortedKeys(g.samples) {
+			values := g.samples[name]
+			if i*maxEMFValues >= len(values) {
+				continue
+			}
+			doc[name] = values[i*maxEMFValues : min((i+1)*maxEMFValues, len(values))]
+			metrics = append(metrics, map[string]string{"Name": name, "Unit": definitions[name].unit})
+		}
+		doc["_aws"] = map[string]any{
+			"Timestamp": timestamp,
+			"CloudWatchMetrics": []map[string]any{{
+				"Namespace":  e.namespace,
+				"Dimensions": [][]string{dimensions},
+				"Metrics":    metrics,
+			}},
+		}
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+// sortedKeys returns the keys of m in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
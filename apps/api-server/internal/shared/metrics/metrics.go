@@ -0,0 +1,175 @@
+package metrics
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/logger"
+)
+
+// Metric names. Counters end in _total; distributions are recorded in milliseconds.
+const (
+	RequestsTotal           = "http_requests_total"                    // route, method, status
+	RequestDuration         = "http_request_duration_milliseconds"     // route, method, status
+	UpstreamRequestDuration = "upstream_request_duration_milliseconds" // source
+	UpstreamErrorsTotal     = "upstream_errors_total"                  // source, kind
+	CacheLookupsTotal       = "upstream_cache_lookups_total"           // source, result (hit, stale, miss)
+	IngestRunsTotal         = "ingest_runs_total"                      // result (success, failure)
+	IngestJobsTotal         = "ingest_jobs_total"                      // result (fetched, new, updated, ...)
+	IngestSourceErrorsTotal = "ingest_source_errors_total"             // source
+)
+
+// kind is how a metric aggregates
+type kind int
+
+const (
+	counter   kind = iota // a sum
+	histogram             // a distribution of samples
+)
+
+// definition describes a metric for the exporters
+type definition struct {
+	kind kind
+	help string
+	unit string // CloudWatch unit
+}
+
+var definitions = map[string]definition{
+	RequestsTotal:           {counter, "HTTP requests served", "Count"},
+	RequestDuration:         {histogram, "Latency of the HTTP requests served", "Milliseconds"},
+	UpstreamRequestDuration: {histogram, "Latency of the requests to the job sources, including failed ones", "Milliseconds"},
+	UpstreamErrorsTotal:     {counter, "Failed requests to the job sources", "Count"},
+	CacheLookupsTotal:       {counter, "Lookups of the upstream response cache", "Count"},
+	IngestRunsTotal:         {counter, "Ingestion runs", "Count"},
+	IngestJobsTotal:         {counter, "Jobs handled by ingestion runs", "Count"},
+	IngestSourceErrorsTotal: {counter, "Sources that failed during an ingestion run", "Count"},
+}
+
+// Label is a dimension of a metric
+type Label struct {
+	Name  string
+	Value string
+}
+
+// L creates a Label
+func L(name, value string) Label {
+	return Label{Name: name, Value: value}
+}
+
+// Recorder records metrics and exports them
+type Recorder interface {
+	// Add increases the counter name by value
+	Add(name string, value float64, labels ...Label)
+	// Observe records a sample of the distribution name
+	Observe(name string, value float64, labels ...Label)
+	// Flush exports the metrics recorded since the last flush, for exporters that push
+	Flush(ctx context.Context) error
+}
+
+// Exporters
+const (
+	ExporterNone       = "none"       // metrics are discarded
+	ExporterEMF        = "emf"        // metrics are written as CloudWatch Embedded Metric Format to the log output on Flush
+	ExporterPrometheus = "prometheus" // metrics are served in the Prometheus text format by Handler
+)
+
+// Config controls how metrics are exported
+type Config struct {
+	Exporter  string // none, emf, prometheus
+	Namespace string // CloudWatch namespace of the EMF metrics
+}
+
+// holder lets an atomic.Pointer hold any Recorder
+type holder struct {
+	Recorder
+}
+
+var current atomic.Pointer[holder]
+
+func init() {
+	current.Store(&holder{Nop{}})
+}
+
+// Init replaces the global Recorder with the exporter of cfg
+func Init(cfg Config) error {
+	switch cfg.Exporter {
+	case "", ExporterNone:
+		SetRecorder(Nop{})
+	case ExporterEMF:
+		SetRecorder(NewEMF(logger.Output(), cfg.Namespace))
+	case ExporterPrometheus:
+		SetRecorder(NewPrometheus())
+	default:
+		return fmt.Errorf("unknown metrics exporter %q (must be none, emf or prometheus)", cfg.Exporter)
+	}
+	return nil
+}
+
+// SetRecorder replaces the global Recorder and returns the previous one
+func SetRecorder(r Recorder) Recorder {
+	return current.Swap(&holder{r}).Recorder
+}
+
+// Add increases the counter name of the global Recorder by value
+func Add(name string, value float64, labels ...Label) {
+	current.Load().Add(name, value, labels...)
+}
+
+// Observe records a sample of the distribution name in the global Recorder
+func Observe(name string, value float64, labels ...Label) {
+	current.Load().Observe(name, value, labels...)
+}
+
+// Flush exports the metrics of the global Recorder. Lambda calls it after every invocation.
+func Flush(ctx context.Context) error {
+	return current.Load().Flush(ctx)
+}
+
+// Handler returns the endpoint serving the global Recorder's metrics, or nil when it is not scraped
+func Handler() http.Handler {
+	h, _ := current.Load().Recorder.(http.Handler)
+	return h
+}
+
+// Nop discards every metric
+type Nop struct{}
+
+func (Nop) Add(string, float64, ...Label)     {}
+func (Nop) Observe(string, float64, ...Label) {}
+func (Nop) Flush(context.Context) error       { return nil }
+
+// series identifies one metric with one set of label values
+type series struct {
+	name   string
+	labels []Label // sorted by name
+}
+
+// newSeries creates the series of name and labels, sorting a copy of labels
+func newSeries(name string, labels []Label) series {
+	sorted := slices.Clone(labels)
+	slices.SortFunc(sorted, func(a, b Label) int { return strings.Compare(a.Name, b.Name) })
+	return series{name: name, labels: sorted}
+}
+
+// key returns a map key unique to the series
+func (s series) key() string {
+	return s.name + "\x00" + labelKey(s.labels)
+}
+
+// labelKey returns a map key unique to a sorted set of labels
+func labelKey(labels []Label) string {
+	var b strings.Builder
+	for _, l := range labels {
+		b.WriteString(l.Name)
+		b.WriteByte('=')
+		b.WriteString(l.Value)
+		b.WriteByte(0)
+	}
+	return b.String()
+}
@@ -0,0 +1,154 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInit(t *testing.T) {
+	tests := []struct {
+		name          string
+		cfg           Config
+		expectHandler bool
+		expectError   bool
+	}{
+		{name: "None: Metrics are discarded", cfg: Config{Exporter: ExporterNone}},
+		{name: "EMF: Nothing is scraped", cfg: Config{Exporter: ExporterEMF, Namespace: "Test"}},
+		{name: "Prometheus: Handler serves the metrics", cfg: Config{Exporter: ExporterPrometheus}, expectHandler: true},
+		{name: "Error: Unknown exporter", cfg: Config{Exporter: "statsd"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			previous := SetRecorder(Nop{})
+			t.Cleanup(func() { SetRecorder(previous) })
+
+			// Act
+			err := Init(tt.cfg)
+
+			// Assert
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if got := Handler() != nil; got != tt.expectHandler {
+				t.Errorf("Expected handler %v, got %v", tt.expectHandler, got)
+			}
+		})
+	}
+}
+
+func TestPrometheus_ServeHTTP(t *testing.T) {
+	// Arrange
+	p := NewPrometheus()
+	p.Add(RequestsTotal, 1, L("route", "/jobs"), L("method", "GET"), L("status", "200"))
+	p.Add(RequestsTotal, 1, L("method", "GET"), L("route", "/jobs"), L("status", "200"))
+	p.Add(RequestsTotal, 1, L("route", "/jobs/{id}"), L("method", "GET"), L("status", "404"))
+	p.Observe(UpstreamRequestDuration, 30, L("source", "a"))
+	p.Observe(UpstreamRequestDuration, 700, L("source", "a"))
+
+	// Act
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	// Assert
+	if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/plain; version=0.0.4") {
+		t.Errorf("Expected the text exposition format, got %q", got)
+	}
+	body := rec.Body.String()
+	for _, line := range []string{
+		"# TYPE http_requests_total counter",
+		`http_requests_total{method="GET",route="/jobs",status="200"} 2`,
+		`http_requests_total{method="GET",route="/jobs/{id}",status="404"} 1`,
+		"# TYPE upstream_request_duration_milliseconds histogram",
+		`upstream_request_duration_milliseconds_bucket{source="a",le="25"} 0`,
+		`upstream_request_duration_milliseconds_bucket{source="a",le="50"} 1`,
+		`upstream_request_duration_milliseconds_bucket{source="a",le="1000"} 2`,
+		`upstream_request_duration_milliseconds_bucket{source="a",le="+Inf"} 2`,
+		`upstream_request_duration_milliseconds_sum{source="a"} 730`,
+		`upstream_request_duration_milliseconds_count{source="a"} 2`,
+	} {
+		if !strings.Contains(body, line+"\n") {
+			t.Errorf("Expected line %q in\n%s", line, body)
+		}
+	}
+}
+
+func TestEMF_Flush(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	e := NewEMF(&buf, "Test")
+	e.now = func() time.Time { return time.UnixMilli(1700000000000) }
+	e.Add(IngestRunsTotal, 1, L("result", "success"))
+	for i := range maxEMFValues + 1 {
+		e.Observe(UpstreamRequestDuration, float64(i), L("source", "a"))
+	}
+	e.Add(UpstreamErrorsTotal, 1, L("source", "a"))
+
+	// Act
+	if err := e.Flush(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Assert: ラベルの組ごとに1ドキュメント、101個のサンプルは2つに分割される
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 documents, got %d:\n%s", len(lines), buf.String())
+	}
+	docs := make([]map[string]any, len(lines))
+	for i, line := range lines {
+		if err := json.Unmarshal([]byte(line), &docs[i]); err != nil {
+			t.Fatalf("Expected JSON, got %q: %v", line, err)
+		}
+	}
+
+	run := docs[0]
+	if run["result"] != "success" || run[IngestRunsTotal] != 1.0 {
+		t.Errorf("Expected the run counter with its dimension, got %v", run)
+	}
+	directive := run["_aws"].(map[string]any)
+	if directive["Timestamp"] != 1700000000000.0 {
+		t.Errorf("Expected the flush timestamp, got %v", directive["Timestamp"])
+	}
+	cw := directive["CloudWatchMetrics"].([]any)[0].(map[string]any)
+	if cw["Namespace"] != "Test" {
+		t.Errorf("Expected namespace Test, got %v", cw["Namespace"])
+	}
+	if dims := cw["Dimensions"].([]any)[0].([]any); len(dims) != 1 || dims[0] != "result" {
+		t.Errorf("Expected dimensions [result], got %v", dims)
+	}
+
+	first, second := docs[1], docs[2]
+	if first[UpstreamErrorsTotal] != 1.0 || len(first[UpstreamRequestDuration].([]any)) != maxEMFValues {
+		t.Errorf("Expected the counter and %d samples in the first document, got %v", maxEMFValues, first)
+	}
+	if _, ok := second[UpstreamErrorsTotal]; ok {
+		t.Errorf("Expected the counter only once, got %v", second)
+	}
+	if samples := second[UpstreamRequestDuration].([]any); len(samples) != 1 || samples[0] != float64(maxEMFValues) {
+		t.Errorf("Expected the last sample in the second document, got %v", samples)
+	}
+
+	// Act: 送信済みのメトリクスは再送しない
+	buf.Reset()
+	if err := e.Flush(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Assert
+	if buf.Len() != 0 {
+		t.Errorf("Expected nothing after a flush, got %q", buf.String())
+	}
+}
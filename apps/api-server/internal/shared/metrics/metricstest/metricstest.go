@@ -0,0 +1,74 @@
+// Package metricstest records the metrics of a test in memory
+package metricstest
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/metrics"
+)
+
+// Recorder keeps every metric recorded so that tests can read them back
+type Recorder struct {
+	mu       sync.Mutex
+	counters map[string]float64
+	samples  map[string][]float64
+}
+
+// Setup installs a Recorder as the global metrics recorder. The previous one is restored when the test ends, so tests
+// using Setup must not run in parallel.
+func Setup(t *testing.T) *Recorder {
+	t.Helper()
+
+	r := &Recorder{counters: make(map[string]float64), samples: make(map[string][]float64)}
+	previous := metrics.SetRecorder(r)
+	t.Cleanup(func() { metrics.SetRecorder(previous) })
+	return r
+}
+
+// Add implements metrics.Recorder
+func (r *Recorder) Add(name string, value float64, labels ...metrics.Label) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.counters[key(name, labels)] += value
+}
+
+// Observe implements metrics.Recorder
+func (r *Recorder) Observe(name string, value float64, labels ...metrics.Label) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	k := key(name, labels)
+	r.samples[k] = append(r.samples[k], value)
+}
+
+// Flush implements metrics.Recorder
+func (r *Recorder) Flush(context.Context) error {
+	return nil
+}
+
+// Counter returns the value of the counter name with exactly labels, in any order
+func (r *Recorder) Counter(name string, labels ...metrics.Label) float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.counters[key(name, labels)]
+}
+
+// Samples returns the samples of the distribution name with exactly labels, in any order
+func (r *Recorder) Samples(name string, labels ...metrics.Label) []float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.samples[key(name, labels)])
+}
+
+// key identifies name and labels regardless of the order of labels
+func key(name string, labels []metrics.Label) string {
+	parts := make([]string, len(labels))
+	for i, l := range labels {
+		parts[i] = l.Name + "=" + l.Value
+	}
+	slices.Sort(parts)
+	return name + "{" + strings.Join(parts, ",") + "}"
+}
@@ -0,0 +1,140 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// latencyBuckets are the upper bounds of the histogram buckets, in milliseconds
+var latencyBuckets = []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+
+// promHistogram is the state of one histogram series
+type promHistogram struct {
+	buckets []uint64 // cumulative count per bound of latencyBuckets
+	sum     float64
+	count   uint64
+}
+
+// Prometheus keeps cumulative metrics and serves them in the Prometheus text exposition format
+type Prometheus struct {
+	mu         sync.Mutex
+	series     map[string]series
+	counters   map[string]float64
+	histograms map[string]*promHistogram
+}
+
+// NewPrometheus creates an empty Prometheus recorder
+func NewPrometheus() *Prometheus {
+	return &Prometheus{
+		series:     make(map[string]series),
+		counters:   make(map[string]float64),
+		histograms: make(map[string]*promHistogram),
+	}
+}
+
+// Add implements Recorder
+func (p *Prometheus) Add(name string, value float64, labels ...Label) {
+	s := newSeries(name, labels)
+	key := s.key()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.series[key] = s
+	p.counters[key] += value
+}
+
+// Observe implements Recorder
+func (p *Prometheus) Observe(name string, value float64, labels ...Label) {
+	s := newSeries(name, labels)
+	key := s.key()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.series[key] = s
+	h, ok := p.histograms[key]
+	if !ok {
+		h = &promHistogram{buckets: make([]uint64, len(latencyBuckets))}
+		p.histograms[key] = h
+	}
+	for i, bound := range latencyBuckets {
+		if value <= bound {
+			h.buckets[i]++
+		}
+	}
+	h.sum += value
+	h.count++
+}
+
+// Flush implements Recorder. Prometheus scrapes the metrics, so there is nothing to push.
+func (p *Prometheus) Flush(context.Context) error {
+	return nil
+}
+
+// ServeHTTP writes every metric in the text exposition format
+func (p *Prometheus) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(p.render()))
+}
+
+// render formats the metrics, grouped by name and sorted so that the output is stable
+func (p *Prometheus) render() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	byName := make(map[string][]string)
+	for key, s := range p.series {
+		byName[s.name] = append(byName[s.name], key)
+	}
+
+	var b strings.Builder
+	for _, name := range sortedKeys(byName) {
+		def := definitions[name]
+		typ := "counter"
+		if def.kind == histogram {
+			typ = "histogram"
+		}
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", name, def.help, name, typ)
+
+		keys := byName[name]
+		slices.Sort(keys)
+		for _, key := range keys {
+			s := p.series[key]
+			if h, ok := p.histograms[key]; ok {
+				for i, bound := range latencyBuckets {
+					fmt.Fprintf(&b, "%s_bucket%s %d\n", name, formatLabels(s.labels, L("le", formatFloat(bound))), h.buckets[i])
+				}
+				fmt.Fprintf(&b, "%s_bucket%s %d\n", name, formatLabels(s.labels, L("le", "+Inf")), h.count)
+				fmt.Fprintf(&b, "%s_sum%s %s\n", name, formatLabels(s.labels), formatFloat(h.sum))
+				fmt.Fprintf(&b, "%s_count%s %d\n", name, formatLabels(s.labels), h.count)
+				continue
+			}
+			fmt.Fprintf(&b, "%s%s %s\n", name, formatLabels(s.labels), formatFloat(p.counters[key]))
+		}
+	}
+	return b.String()
+}
+
+// formatLabels formats labels as {name="value",...}, escaping the values
+func formatLabels(labels []Label, extra ...Label) string {
+	all := append(slices.Clone(labels), extra...)
+	if len(all) == 0 {
+		return ""
+	}
+	parts := make([]string, len(all))
+	for i, l := range all {
+		value := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(l.Value)
+		parts[i] = l.Name + `="` + value + `"`
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+// formatFloat formats v as Prometheus expects
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
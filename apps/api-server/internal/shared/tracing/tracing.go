@@ -0,0 +1,118 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies the spans this service creates
+const instrumentationName = "github.com/tmizuma/japan-tech-careers-api/apps/api-server"
+
+// ServiceName is the service.name resource attribute of exported spans
+const ServiceName = "japan-tech-careers-api"
+
+// Exporters
+const (
+	ExporterNone   = "none"   // spans are not recorded; incoming trace context is still propagated
+	ExporterStdout = "stdout" // spans are written to stdout as JSON
+	ExporterOTLP   = "otlp"   // spans are sent to an OTLP/HTTP collector
+)
+
+// Config controls how spans are exported
+type Config struct {
+	Exporter     string  // none, stdout, otlp
+	OTLPEndpoint string  // URL of the OTLP/HTTP traces endpoint; empty uses the OTEL_EXPORTER_OTLP_* environment variables
+	SampleRatio  float64 // fraction of new traces that are sampled; a sampled parent is always followed
+	Environment  string  // deployment.environment.name resource attribute
+}
+
+var provider *sdktrace.TracerProvider
+
+// Init installs the W3C trace context propagator and, unless cfg.Exporter is none, a tracer provider exporting to
+// cfg.Exporter. It must be called at most once, before any request is served.
+func Init(ctx context.Context, cfg Config) error {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	switch cfg.Exporter {
+	case "", ExporterNone:
+		return nil
+	case ExporterStdout:
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return fmt.Errorf("failed to create stdout span exporter: %w", err)
+		}
+		exporter = exp
+	case ExporterOTLP:
+		var opts []otlptracehttp.Option
+		if cfg.OTLPEndpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(cfg.OTLPEndpoint))
+		}
+		exp, err := otlptracehttp.New(ctx, opts...)
+		if err != nil {
+			return fmt.Errorf("failed to create OTLP span exporter: %w", err)
+		}
+		exporter = exp
+	default:
+		return fmt.Errorf("unknown tracing exporter %q (must be none, stdout or otlp)", cfg.Exporter)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(ServiceName),
+		semconv.DeploymentEnvironmentNameKey.String(cfg.Environment),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to build tracing resource: %w", err)
+	}
+
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return nil
+}
+
+// ForceFlush exports the spans that are still buffered. Lambda calls it after every invocation, because a frozen
+// execution environment cannot export in the background.
+func ForceFlush(ctx context.Context) error {
+	if provider == nil {
+		return nil
+	}
+	return provider.ForceFlush(ctx)
+}
+
+// Shutdown flushes and stops the exporter
+func Shutdown(ctx context.Context) error {
+	if provider == nil {
+		return nil
+	}
+	return provider.Shutdown(ctx)
+}
+
+// Start starts a span named name as a child of the span in ctx
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// End ends span, marking it as failed when err is not nil. A canceled request is not a failure of the span.
+func End(span trace.Span, err error) {
+	if err != nil && !errors.Is(err, context.Canceled) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
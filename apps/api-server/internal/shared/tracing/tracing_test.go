@@ -0,0 +1,131 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/apperr"
+	"github.com/tmizuma/japan-tech-careers-api/apps/api-server/internal/shared/tracing/tracingtest"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func TestInit(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            Config
+		expectProvider bool
+		expectError    bool
+	}{
+		{
+			name: "None: No provider is installed",
+			cfg:  Config{Exporter: ExporterNone},
+		},
+		{
+			name: "Empty: Same as none",
+			cfg:  Config{},
+		},
+		{
+			name:           "Stdout: Provider is installed",
+			cfg:            Config{Exporter: ExporterStdout, SampleRatio: 1, Environment: "local"},
+			expectProvider: true,
+		},
+		{
+			name:           "OTLP: Provider is installed without connecting",
+			cfg:            Config{Exporter: ExporterOTLP, OTLPEndpoint: "http://localhost:4318/v1/traces", SampleRatio: 0.5, Environment: "dev"},
+			expectProvider: true,
+		},
+		{
+			name:        "Error: Unknown exporter",
+			cfg:         Config{Exporter: "jaeger"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange: グローバルな状態を元に戻す
+			previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+			t.Cleanup(func() {
+				Shutdown(context.Background())
+				provider = nil
+				otel.SetTracerProvider(previousProvider)
+				otel.SetTextMapPropagator(previousPropagator)
+			})
+
+			// Act
+			err := Init(context.Background(), tt.cfg)
+
+			// Assert
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if (provider != nil) != tt.expectProvider {
+				t.Errorf("Expected provider=%v, got %v", tt.expectProvider, provider)
+			}
+			// The composite propagator collects its fields through a map, so their order is not fixed
+			if fields := otel.GetTextMapPropagator().Fields(); !slices.Contains(fields, "traceparent") {
+				t.Errorf("Expected the W3C trace context propagator, got fields %v", fields)
+			}
+			if err := ForceFlush(context.Background()); err != nil {
+				t.Errorf("Expected ForceFlush to succeed, got %v", err)
+			}
+		})
+	}
+}
+
+func TestEnd(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus codes.Code
+		expectEvent    bool
+	}{
+		{
+			name:           "Success: Status is left unset",
+			expectedStatus: codes.Unset,
+		},
+		{
+			name:           "Error: Status and exception event are recorded",
+			err:            apperr.New(apperr.UpstreamTimeout, "upstream request timed out"),
+			expectedStatus: codes.Error,
+			expectEvent:    true,
+		},
+		{
+			name:           "Canceled: Not a failure",
+			err:            errors.Join(errors.New("fetch failed"), context.Canceled),
+			expectedStatus: codes.Unset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			exporter := tracingtest.Setup(t)
+			_, span := Start(context.Background(), "ServiceImpl.FetchJobs")
+
+			// Act
+			End(span, tt.err)
+
+			// Assert
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("Expected 1 span, got %d", len(spans))
+			}
+			if spans[0].Status.Code != tt.expectedStatus {
+				t.Errorf("Expected status %v, got %v", tt.expectedStatus, spans[0].Status)
+			}
+			if (len(spans[0].Events) > 0) != tt.expectEvent {
+				t.Errorf("Unexpected events: %+v", spans[0].Events)
+			}
+		})
+	}
+}